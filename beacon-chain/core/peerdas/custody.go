@@ -0,0 +1,26 @@
+package peerdas
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+)
+
+var errCustodySubnetCountTooLarge = errors.New("custody subnet count larger than data column sidecar subnet count")
+
+// CustodyColumnCount returns the number of columns custodied by a node custodying
+// `custodySubnetCount` data column sidecar subnets. Since every subnet contains the
+// same number of columns, this does not depend on the node ID: when
+// `custodySubnetCount <= DATA_COLUMN_SIDECAR_SUBNET_COUNT`, it equals the number of
+// columns returned by `get_custody_columns` for any node ID. Otherwise, a node cannot
+// custody that many subnets, and an error wrapping `errCustodySubnetCountTooLarge` is
+// returned instead of a count.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#get_custody_columns
+func CustodyColumnCount(custodySubnetCount uint64) (uint64, error) {
+	dataColumnSidecarSubnetCount := params.BeaconConfig().DataColumnSidecarSubnetCount
+	if custodySubnetCount > dataColumnSidecarSubnetCount {
+		return 0, errors.Wrapf(errCustodySubnetCountTooLarge, "custody subnet count %d, subnet count %d", custodySubnetCount, dataColumnSidecarSubnetCount)
+	}
+
+	columnsPerSubnet := params.BeaconConfig().NumberOfColumns / dataColumnSidecarSubnetCount
+	return columnsPerSubnet * custodySubnetCount, nil
+}
@@ -0,0 +1,36 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCustodyColumnCount(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig()
+	config.NumberOfColumns = 128
+	config.DataColumnSidecarSubnetCount = 32
+	params.OverrideBeaconConfig(config)
+
+	testCases := []struct {
+		custodySubnetCount uint64
+		expected           uint64
+	}{
+		{custodySubnetCount: 0, expected: 0},
+		{custodySubnetCount: 1, expected: 4},
+		{custodySubnetCount: 4, expected: 16},
+		{custodySubnetCount: 32, expected: 128},
+	}
+
+	for _, tc := range testCases {
+		actual, err := peerdas.CustodyColumnCount(tc.custodySubnetCount)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, actual)
+	}
+
+	_, err := peerdas.CustodyColumnCount(33)
+	require.ErrorContains(t, "custody subnet count larger than data column sidecar subnet count", err)
+}
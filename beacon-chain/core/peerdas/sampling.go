@@ -0,0 +1,113 @@
+// Package peerdas contains helpers for peer data availability sampling (PeerDAS),
+// such as mapping data columns to the peers which custody them.
+package peerdas
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+)
+
+var (
+	errNoCustodian           = errors.New("no peer custodies column")
+	errSamplingCountTooLarge = errors.New("sampling count larger than number of columns")
+)
+
+// SampleColumns plans a sampling query by assigning each requested column to a peer
+// which custodies it, according to `peerCustody`. Among the capable peers, the one
+// with the fewest columns already assigned is selected, ties being broken by the
+// lowest node ID, so the plan is deterministic and spreads the load across peers.
+// An error is returned if any requested column is not custodied by any peer.
+func SampleColumns(
+	ctx context.Context,
+	columns []uint64,
+	peerCustody map[enode.ID]map[uint64]bool,
+) (map[uint64]enode.ID, error) {
+	// Sort peers by node ID so that the resulting plan does not depend on map iteration order.
+	peers := make([]enode.ID, 0, len(peerCustody))
+	for peer := range peerCustody {
+		peers = append(peers, peer)
+	}
+
+	sort.Slice(peers, func(i, j int) bool {
+		return bytes.Compare(peers[i][:], peers[j][:]) < 0
+	})
+
+	assignedCountByPeer := make(map[enode.ID]int, len(peers))
+	peerByColumn := make(map[uint64]enode.ID, len(columns))
+
+	for _, column := range columns {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// The same column may be requested several times, only sample it once.
+		if _, ok := peerByColumn[column]; ok {
+			continue
+		}
+
+		var (
+			selected enode.ID
+			found    bool
+		)
+
+		for _, peer := range peers {
+			if !peerCustody[peer][column] {
+				continue
+			}
+
+			if !found || assignedCountByPeer[peer] < assignedCountByPeer[selected] {
+				selected, found = peer, true
+			}
+		}
+
+		if !found {
+			return nil, errors.Wrapf(errNoCustodian, "column %d", column)
+		}
+
+		peerByColumn[column] = selected
+		assignedCountByPeer[selected]++
+	}
+
+	return peerByColumn, nil
+}
+
+// SamplingColumns returns `count` distinct column indices in `[0, NumberOfColumns)` to sample
+// at `slot`. The selection is a partial Fisher-Yates shuffle of all columns, where the swap
+// position of the i-th draw is derived from `hash(seed || slot || i)`, so the same seed and
+// slot always yield the same columns in the same order. It returns an error if `count` is
+// higher than the number of columns.
+func SamplingColumns(seed [32]byte, slot primitives.Slot, count uint64) ([]uint64, error) {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	if count > numberOfColumns {
+		return nil, errors.Wrapf(errSamplingCountTooLarge, "count %d, number of columns %d", count, numberOfColumns)
+	}
+
+	columns := make([]uint64, numberOfColumns)
+	for i := uint64(0); i < numberOfColumns; i++ {
+		columns[i] = i
+	}
+
+	// The hash input is `seed || slot || i`, integers being encoded as little endian.
+	input := make([]byte, len(seed)+16)
+	copy(input, seed[:])
+	binary.LittleEndian.PutUint64(input[len(seed):], uint64(slot))
+
+	for i := uint64(0); i < count; i++ {
+		binary.LittleEndian.PutUint64(input[len(seed)+8:], i)
+		digest := hash.Hash(input)
+
+		// Pick one of the columns not drawn yet and move it to the drawn prefix.
+		j := i + binary.LittleEndian.Uint64(digest[:8])%(numberOfColumns-i)
+		columns[i], columns[j] = columns[j], columns[i]
+	}
+
+	return columns[:count:count], nil
+}
@@ -0,0 +1,123 @@
+package peerdas_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSampleColumns(t *testing.T) {
+	ctx := context.Background()
+
+	peer1, peer2, peer3 := enode.ID{1}, enode.ID{2}, enode.ID{3}
+
+	peerCustody := map[enode.ID]map[uint64]bool{
+		peer1: {0: true, 1: true, 2: true},
+		peer2: {1: true, 3: true},
+		peer3: {2: true, 4: true},
+	}
+
+	t.Run("all columns covered", func(t *testing.T) {
+		columns := []uint64{0, 1, 2, 3, 4}
+
+		peerByColumn, err := peerdas.SampleColumns(ctx, columns, peerCustody)
+		require.NoError(t, err)
+		require.Equal(t, len(columns), len(peerByColumn))
+
+		for _, column := range columns {
+			peer, ok := peerByColumn[column]
+			require.Equal(t, true, ok)
+			require.Equal(t, true, peerCustody[peer][column])
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		columns := []uint64{4, 2, 1, 0, 3}
+
+		expected, err := peerdas.SampleColumns(ctx, columns, peerCustody)
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			actual, err := peerdas.SampleColumns(ctx, columns, peerCustody)
+			require.NoError(t, err)
+			require.DeepEqual(t, expected, actual)
+		}
+	})
+
+	t.Run("uncovered column", func(t *testing.T) {
+		_, err := peerdas.SampleColumns(ctx, []uint64{0, 5}, peerCustody)
+		require.ErrorContains(t, "no peer custodies column", err)
+	})
+}
+
+func TestSamplingColumns(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig()
+	config.NumberOfColumns = 128
+	params.OverrideBeaconConfig(config)
+
+	seed := [32]byte{0x01, 0x02, 0x03}
+
+	samplingColumns := func(t *testing.T, seed [32]byte, slot primitives.Slot, count uint64) []uint64 {
+		columns, err := peerdas.SamplingColumns(seed, slot, count)
+		require.NoError(t, err)
+		return columns
+	}
+
+	t.Run("deterministic", func(t *testing.T) {
+		expected := samplingColumns(t, seed, 42, 16)
+		require.Equal(t, 16, len(expected))
+
+		for i := 0; i < 10; i++ {
+			actual := samplingColumns(t, seed, 42, 16)
+			require.DeepEqual(t, expected, actual)
+		}
+	})
+
+	t.Run("depends on seed and slot", func(t *testing.T) {
+		columns := samplingColumns(t, seed, 42, 16)
+		require.DeepNotEqual(t, columns, samplingColumns(t, seed, 43, 16))
+		require.DeepNotEqual(t, columns, samplingColumns(t, [32]byte{0x04}, 42, 16))
+	})
+
+	t.Run("distinct and in range", func(t *testing.T) {
+		for slot := primitives.Slot(0); slot < 32; slot++ {
+			columns := samplingColumns(t, seed, slot, 64)
+			require.Equal(t, 64, len(columns))
+
+			seen := make(map[uint64]bool, len(columns))
+			for _, column := range columns {
+				require.Equal(t, true, column < config.NumberOfColumns)
+				require.Equal(t, false, seen[column])
+				seen[column] = true
+			}
+		}
+	})
+
+	t.Run("zero count", func(t *testing.T) {
+		require.Equal(t, 0, len(samplingColumns(t, seed, 42, 0)))
+	})
+
+	t.Run("all columns", func(t *testing.T) {
+		columns := samplingColumns(t, seed, 42, config.NumberOfColumns)
+		require.Equal(t, int(config.NumberOfColumns), len(columns))
+
+		seen := make(map[uint64]bool, len(columns))
+		for _, column := range columns {
+			require.Equal(t, true, column < config.NumberOfColumns)
+			seen[column] = true
+		}
+
+		require.Equal(t, int(config.NumberOfColumns), len(seen))
+	})
+
+	t.Run("count larger than the number of columns", func(t *testing.T) {
+		_, err := peerdas.SamplingColumns(seed, 42, config.NumberOfColumns+1)
+		require.ErrorContains(t, "sampling count larger than number of columns", err)
+	})
+}
@@ -0,0 +1,51 @@
+package peerdas
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+)
+
+var errIndexTooLarge = errors.New("index too large")
+
+// SubnetForColumn returns the data column sidecar subnet a column belongs to.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#compute_subnet_for_data_column_sidecar
+func SubnetForColumn(columnIndex uint64) (uint64, error) {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	if columnIndex >= numberOfColumns {
+		return 0, errors.Wrapf(errIndexTooLarge, "column index %d, number of columns %d", columnIndex, numberOfColumns)
+	}
+
+	dataColumnSidecarSubnetCount := params.BeaconConfig().DataColumnSidecarSubnetCount
+	return columnIndex % dataColumnSidecarSubnetCount, nil
+}
+
+// ColumnsForSubnet returns the column indices, in ascending order, belonging to a data column sidecar subnet.
+// Column indices of a subnet are spaced by the subnet count: the subnet `subnetId` contains
+// columns `dataColumnSidecarSubnetCount*i + subnetId` for `i` in `[0, columnsPerSubnet)`.
+func ColumnsForSubnet(subnetId uint64) ([]uint64, error) {
+	dataColumnSidecarSubnetCount := params.BeaconConfig().DataColumnSidecarSubnetCount
+	if subnetId >= dataColumnSidecarSubnetCount {
+		return nil, errors.Wrapf(errIndexTooLarge, "subnet ID %d, subnet count %d", subnetId, dataColumnSidecarSubnetCount)
+	}
+
+	columnsPerSubnet := params.BeaconConfig().NumberOfColumns / dataColumnSidecarSubnetCount
+
+	columns := make([]uint64, 0, columnsPerSubnet)
+	for i := uint64(0); i < columnsPerSubnet; i++ {
+		columns = append(columns, dataColumnSidecarSubnetCount*i+subnetId)
+	}
+
+	return columns, nil
+}
+
+// ColumnBelongsToSubnet returns true if the column belongs to the data column sidecar subnet,
+// consistently with `SubnetForColumn`. It returns false for an out of range column index.
+// Gossip validation should reject a data column sidecar received on a subnet it does not belong to.
+func ColumnBelongsToSubnet(columnIndex, subnetId uint64) bool {
+	subnet, err := SubnetForColumn(columnIndex)
+	if err != nil {
+		return false
+	}
+
+	return subnet == subnetId
+}
@@ -0,0 +1,92 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSubnetForColumn(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig()
+	config.NumberOfColumns = 128
+	config.DataColumnSidecarSubnetCount = 32
+	params.OverrideBeaconConfig(config)
+
+	testCases := []struct {
+		columnIndex    uint64
+		expectedSubnet uint64
+	}{
+		{columnIndex: 0, expectedSubnet: 0},
+		{columnIndex: 1, expectedSubnet: 1},
+		{columnIndex: 31, expectedSubnet: 31},
+		{columnIndex: 32, expectedSubnet: 0},
+		{columnIndex: 127, expectedSubnet: 31},
+	}
+
+	for _, tc := range testCases {
+		actual, err := peerdas.SubnetForColumn(tc.columnIndex)
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedSubnet, actual)
+	}
+
+	_, err := peerdas.SubnetForColumn(128)
+	require.ErrorContains(t, "index too large", err)
+}
+
+func TestColumnsForSubnet(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig()
+	config.NumberOfColumns = 128
+	config.DataColumnSidecarSubnetCount = 32
+	params.OverrideBeaconConfig(config)
+
+	columns, err := peerdas.ColumnsForSubnet(3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{3, 35, 67, 99}, columns)
+
+	// Every column of a subnet maps back to this subnet.
+	for subnet := uint64(0); subnet < config.DataColumnSidecarSubnetCount; subnet++ {
+		columns, err := peerdas.ColumnsForSubnet(subnet)
+		require.NoError(t, err)
+
+		for _, column := range columns {
+			actual, err := peerdas.SubnetForColumn(column)
+			require.NoError(t, err)
+			require.Equal(t, subnet, actual)
+		}
+	}
+
+	_, err = peerdas.ColumnsForSubnet(32)
+	require.ErrorContains(t, "index too large", err)
+}
+
+func TestColumnBelongsToSubnet(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig()
+	config.NumberOfColumns = 128
+	config.DataColumnSidecarSubnetCount = 32
+	params.OverrideBeaconConfig(config)
+
+	testCases := []struct {
+		columnIndex uint64
+		subnetId    uint64
+		expected    bool
+	}{
+		{columnIndex: 0, subnetId: 0, expected: true},
+		{columnIndex: 3, subnetId: 3, expected: true},
+		{columnIndex: 35, subnetId: 3, expected: true},
+		{columnIndex: 127, subnetId: 31, expected: true},
+		{columnIndex: 35, subnetId: 4, expected: false},
+		{columnIndex: 32, subnetId: 1, expected: false},
+		{columnIndex: 3, subnetId: 35, expected: false},
+		{columnIndex: 128, subnetId: 0, expected: false},
+	}
+
+	for _, tc := range testCases {
+		actual := peerdas.ColumnBelongsToSubnet(tc.columnIndex, tc.subnetId)
+		require.Equal(t, tc.expected, actual)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"os"
@@ -876,6 +877,11 @@ func (b *BeaconNode) registerSlasherService() error {
 		return err
 	}
 
+	elementWidth := b.cliCtx.Uint64(flags.SlasherElementWidthFlag.Name)
+	if elementWidth > math.MaxUint8 {
+		return fmt.Errorf("unsupported slasher element width %d", elementWidth)
+	}
+
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
@@ -887,6 +893,9 @@ func (b *BeaconNode) registerSlasherService() error {
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
 		ClockWaiter:             b.clockWaiter,
+		MinSlashedValidators:    b.cliCtx.Uint64(flags.SlasherMinSlashedValidatorsFlag.Name),
+		NearSlashableMargin:     b.cliCtx.Uint64(flags.SlasherNearSlashableMarginFlag.Name),
+		ElementWidth:            slasher.ElementWidth(elementWidth),
 	})
 	if err != nil {
 		return err
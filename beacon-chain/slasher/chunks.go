@@ -1,30 +1,48 @@
 package slasher
 
 import (
-	"bytes"
+	"cmp"
 	"context"
 	"fmt"
 	"math"
+	"slices"
 
 	"github.com/pkg/errors"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prometheus/client_golang/prometheus"
 	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
-	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/sirupsen/logrus"
 )
 
+// AttestationRecordReader reads the attestation records needed to confirm a slashable offense.
+// It is the subset of the slasher database used by chunks slices, so that tests and lightweight
+// tools can provide a memory-backed implementation instead.
+type AttestationRecordReader interface {
+	AttestationRecordForValidator(
+		ctx context.Context, validatorIdx primitives.ValidatorIndex, targetEpoch primitives.Epoch,
+	) (*slashertypes.IndexedAttestationWrapper, error)
+}
+
+// ChunkReader reads attestation records as well as min and max span chunks from the slasher database.
+type ChunkReader interface {
+	AttestationRecordReader
+	LoadSlasherChunks(
+		ctx context.Context, kind slashertypes.ChunkKind, diskKeys [][]byte,
+	) ([][]uint16, []bool, error)
+}
+
 // Chunker defines a struct which represents a slice containing a chunk for K different validator's
 // min/max spans used for surround vote detection in slasher. The interface defines methods used to check
 // if an attestation is slashable for a validator index based on the contents of
 // the chunk as well as the ability to update the data in the chunk with incoming information.
 type Chunker interface {
-	NeutralElement() uint16
+	NeutralElement() uint32
 	Chunk() []uint16
 	CheckSlashable(
 		ctx context.Context,
-		slasherDB db.SlasherDatabase,
+		recordReader AttestationRecordReader,
 		validatorIdx primitives.ValidatorIndex,
 		attestation *slashertypes.IndexedAttestationWrapper,
 	) (ethpb.AttSlashing, error)
@@ -39,6 +57,28 @@ type Chunker interface {
 	NextChunkStartEpoch(startEpoch primitives.Epoch) primitives.Epoch
 }
 
+// SlashableResult wraps an attester slashing detected by a min or max span chunks slice
+// together with the target epoch stored in the span which triggered the detection.
+type SlashableResult struct {
+	Slashing ethpb.AttSlashing
+	// MinTarget is the min span target epoch surrounded by the slashable attestation.
+	// It is only set for slashings detected by a min span chunks slice.
+	MinTarget primitives.Epoch
+	// MaxTarget is the max span target epoch surrounding the slashable attestation.
+	// It is only set for slashings detected by a max span chunks slice.
+	MaxTarget primitives.Epoch
+}
+
+// surroundVote is a surround vote detected by a min or max span chunks slice.
+type surroundVote struct {
+	slashing ethpb.AttSlashing
+	// spanTarget is the target epoch stored in the span at the incoming attestation source epoch.
+	spanTarget primitives.Epoch
+	// existingAttWrapper is the attestation record, read from the database, which surrounds
+	// or is surrounded by the incoming attestation.
+	existingAttWrapper *slashertypes.IndexedAttestationWrapper
+}
+
 // MinSpanChunksSlice represents a slice containing a chunk for K different validator's min spans.
 //
 // For a given epoch, e, and attestations a validator index has produced, atts,
@@ -95,38 +135,62 @@ var _ Chunker = (*MaxSpanChunksSlice)(nil)
 
 // EmptyMinSpanChunksSlice initializes a min span chunk of length C*K for
 // C = chunkSize and K = validatorChunkSize filled with neutral elements.
-// For min spans, the neutral element is `undefined`, represented by MaxUint16.
+// For min spans, the neutral element is `undefined`, represented by the maximum element value.
 func EmptyMinSpanChunksSlice(params *Parameters) *MinSpanChunksSlice {
-	m := &MinSpanChunksSlice{
+	// The neutral element for min spans has all its bits set whatever the element width,
+	// so every uint16 word of the chunk is set to MaxUint16.
+	data := make([]uint16, params.chunkLength())
+	fillChunk(data, math.MaxUint16)
+	return &MinSpanChunksSlice{
 		params: params,
+		data:   data,
 	}
-	data := make([]uint16, params.chunkSize*params.validatorChunkSize)
-	for i := 0; i < len(data); i++ {
-		data[i] = m.NeutralElement()
-	}
-	m.data = data
-	return m
 }
 
 // EmptyMaxSpanChunksSlice initializes a max span chunk of length C*K for
 // C = chunkSize and K = validatorChunkSize filled with neutral elements.
 // For max spans, the neutral element is 0.
 func EmptyMaxSpanChunksSlice(params *Parameters) *MaxSpanChunksSlice {
-	m := &MaxSpanChunksSlice{
+	// The neutral element for max spans is 0, which is already
+	// the zero value of a freshly allocated slice.
+	return &MaxSpanChunksSlice{
 		params: params,
+		data:   make([]uint16, params.chunkLength()),
 	}
-	data := make([]uint16, params.chunkSize*params.validatorChunkSize)
-	for i := 0; i < len(data); i++ {
-		data[i] = m.NeutralElement()
+}
+
+// FilledMinSpanChunksSlice initializes a min span chunk of length C*K for
+// C = chunkSize and K = validatorChunkSize with every element set to `value`.
+// Since a min span is the distance to the target of an attestation with a later source,
+// `value` must be in `[1, historyLength]`.
+func FilledMinSpanChunksSlice(params *Parameters, value uint16) (*MinSpanChunksSlice, error) {
+	if value == 0 || uint64(value) > uint64(params.historyLength) {
+		return nil, fmt.Errorf("min span value %d out of range [1, %d]", value, params.historyLength)
 	}
-	m.data = data
-	return m
+	return &MinSpanChunksSlice{
+		params: params,
+		data:   filledChunk(params, value),
+	}, nil
+}
+
+// FilledMaxSpanChunksSlice initializes a max span chunk of length C*K for
+// C = chunkSize and K = validatorChunkSize with every element set to `value`.
+// A max span is at most the history length, so `value` must be in `[0, historyLength]`.
+func FilledMaxSpanChunksSlice(params *Parameters, value uint16) (*MaxSpanChunksSlice, error) {
+	if uint64(value) > uint64(params.historyLength) {
+		return nil, fmt.Errorf("max span value %d out of range [0, %d]", value, params.historyLength)
+	}
+	return &MaxSpanChunksSlice{
+		params: params,
+		data:   filledChunk(params, value),
+	}, nil
 }
 
 // MinChunkSpansSliceFrom initializes a min span chunks slice from a slice of uint16 values.
-// Returns an error if the slice is not of length C*K for C = chunkSize and K = validatorChunkSize.
+// Returns an error if the slice does not contain C*K elements for C = chunkSize and K = validatorChunkSize,
+// each element being stored on one uint16 word per 16 bits of element width.
 func MinChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MinSpanChunksSlice, error) {
-	requiredLen := params.chunkSize * params.validatorChunkSize
+	requiredLen := params.chunkLength()
 	if uint64(len(chunk)) != requiredLen {
 		return nil, fmt.Errorf("chunk has wrong length, %d, expected %d", len(chunk), requiredLen)
 	}
@@ -137,9 +201,10 @@ func MinChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MinSpanChunksS
 }
 
 // MaxChunkSpansSliceFrom initializes a max span chunks slice from a slice of uint16 values.
-// Returns an error if the slice is not of length C*K for C = chunkSize and K = validatorChunkSize.
+// Returns an error if the slice does not contain C*K elements for C = chunkSize and K = validatorChunkSize,
+// each element being stored on one uint16 word per 16 bits of element width.
 func MaxChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MaxSpanChunksSlice, error) {
-	requiredLen := params.chunkSize * params.validatorChunkSize
+	requiredLen := params.chunkLength()
 	if uint64(len(chunk)) != requiredLen {
 		return nil, fmt.Errorf("chunk has wrong length, %d, expected %d", len(chunk), requiredLen)
 	}
@@ -149,14 +214,54 @@ func MaxChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MaxSpanChunksS
 	}, nil
 }
 
+// MergeMinSpanChunks merges two min span chunks slices holding the same validator chunk index and
+// chunk index, for instance computed by slashers processing disjoint sets of attestations.
+// Every element of the result is the minimum of the corresponding elements of `a` and `b`.
+// Returns an error if the slices do not share identical parameters.
+func MergeMinSpanChunks(a, b *MinSpanChunksSlice) (*MinSpanChunksSlice, error) {
+	if a == nil || b == nil {
+		return nil, errors.New("cannot merge nil min span chunks")
+	}
+	data, err := mergeChunks(a.params, b.params, a.data, b.data, func(x, y uint32) uint32 { return min(x, y) })
+	if err != nil {
+		return nil, errors.Wrap(err, "could not merge min span chunks")
+	}
+	return &MinSpanChunksSlice{
+		params: a.params,
+		data:   data,
+	}, nil
+}
+
+// MergeMaxSpanChunks merges two max span chunks slices holding the same validator chunk index and
+// chunk index, for instance computed by slashers processing disjoint sets of attestations.
+// Every element of the result is the maximum of the corresponding elements of `a` and `b`.
+// Returns an error if the slices do not share identical parameters.
+func MergeMaxSpanChunks(a, b *MaxSpanChunksSlice) (*MaxSpanChunksSlice, error) {
+	if a == nil || b == nil {
+		return nil, errors.New("cannot merge nil max span chunks")
+	}
+	data, err := mergeChunks(a.params, b.params, a.data, b.data, func(x, y uint32) uint32 { return max(x, y) })
+	if err != nil {
+		return nil, errors.Wrap(err, "could not merge max span chunks")
+	}
+	return &MaxSpanChunksSlice{
+		params: a.params,
+		data:   data,
+	}, nil
+}
+
 // NeutralElement for a min span chunks slice is undefined, in this case
-// using MaxUint16 as a sane value given it is impossible we reach it.
-func (*MinSpanChunksSlice) NeutralElement() uint16 {
+// using the maximum element value as a sane value given it is impossible we reach it.
+func (m *MinSpanChunksSlice) NeutralElement() uint32 {
+	if m.params.ElementWidth() == ElementWidth32 {
+		return math.MaxUint32
+	}
+
 	return math.MaxUint16
 }
 
 // NeutralElement for a max span chunks slice is 0.
-func (*MaxSpanChunksSlice) NeutralElement() uint16 {
+func (*MaxSpanChunksSlice) NeutralElement() uint32 {
 	return 0
 }
 
@@ -165,11 +270,49 @@ func (m *MinSpanChunksSlice) Chunk() []uint16 {
 	return m.data
 }
 
+// Clone returns a deep copy of the min span chunks slice. The parameters are immutable and thus shared.
+// The clone is safe to read while the original is being updated, and conversely.
+func (m *MinSpanChunksSlice) Clone() Chunker {
+	return &MinSpanChunksSlice{
+		params: m.params,
+		data:   slices.Clone(m.data),
+	}
+}
+
+// DataAtEpoch returns the target epoch stored in the min span chunks slice for a validator index and epoch.
+func (m *MinSpanChunksSlice) DataAtEpoch(validatorIdx primitives.ValidatorIndex, epoch primitives.Epoch) (primitives.Epoch, error) {
+	return chunkDataAtEpoch(m.params, m.data, validatorIdx, epoch)
+}
+
+// SetDataAtEpoch stores a target epoch in the min span chunks slice for a validator index and epoch.
+func (m *MinSpanChunksSlice) SetDataAtEpoch(validatorIdx primitives.ValidatorIndex, epoch, targetEpoch primitives.Epoch) error {
+	return setChunkDataAtEpoch(m.params, m.data, validatorIdx, epoch, targetEpoch)
+}
+
 // Chunk returns the underlying slice of uint16's for the max chunks slice.
 func (m *MaxSpanChunksSlice) Chunk() []uint16 {
 	return m.data
 }
 
+// Clone returns a deep copy of the max span chunks slice. The parameters are immutable and thus shared.
+// The clone is safe to read while the original is being updated, and conversely.
+func (m *MaxSpanChunksSlice) Clone() Chunker {
+	return &MaxSpanChunksSlice{
+		params: m.params,
+		data:   slices.Clone(m.data),
+	}
+}
+
+// DataAtEpoch returns the target epoch stored in the max span chunks slice for a validator index and epoch.
+func (m *MaxSpanChunksSlice) DataAtEpoch(validatorIdx primitives.ValidatorIndex, epoch primitives.Epoch) (primitives.Epoch, error) {
+	return chunkDataAtEpoch(m.params, m.data, validatorIdx, epoch)
+}
+
+// SetDataAtEpoch stores a target epoch in the max span chunks slice for a validator index and epoch.
+func (m *MaxSpanChunksSlice) SetDataAtEpoch(validatorIdx primitives.ValidatorIndex, epoch, targetEpoch primitives.Epoch) error {
+	return setChunkDataAtEpoch(m.params, m.data, validatorIdx, epoch, targetEpoch)
+}
+
 // CheckSlashable takes in a validator index and an incoming attestation
 // and checks if the validator is slashable depending on the data
 // within the min span chunks slice. Recall that for an incoming attestation, B, and an
@@ -183,10 +326,34 @@ func (m *MaxSpanChunksSlice) Chunk() []uint16 {
 // to be confident of a slashable offense.
 func (m *MinSpanChunksSlice) CheckSlashable(
 	ctx context.Context,
-	slasherDB db.SlasherDatabase,
+	recordReader AttestationRecordReader,
 	validatorIdx primitives.ValidatorIndex,
 	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
 ) (ethpb.AttSlashing, error) {
+	vote, err := m.checkSlashable(ctx, recordReader, validatorIdx, incomingAttWrapper)
+	if err != nil || vote == nil {
+		return nil, err
+	}
+
+	return vote.slashing, nil
+}
+
+// Checks if the incoming attestation is slashable as `CheckSlashable` does, and returns
+// the detected surround vote, or nil if the attestation is not slashable.
+func (m *MinSpanChunksSlice) checkSlashable(
+	ctx context.Context,
+	recordReader AttestationRecordReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (*surroundVote, error) {
+	defer prometheus.NewTimer(minSpanCheckSlashableSeconds).ObserveDuration()
+
+	// Span distances are computed as `target - source`, so a malformed attestation would
+	// produce nonsensical spans.
+	if err := validateAttestationIntegrity(incomingAttWrapper.IndexedAttestation); err != nil {
+		return nil, errors.Wrap(err, "invalid attestation")
+	}
+
 	sourceEpoch := incomingAttWrapper.IndexedAttestation.GetData().Source.Epoch
 	targetEpoch := incomingAttWrapper.IndexedAttestation.GetData().Target.Epoch
 
@@ -203,7 +370,7 @@ func (m *MinSpanChunksSlice) CheckSlashable(
 	}
 
 	// The incoming attestation surrounds an existing one.
-	existingAttWrapper, err := slasherDB.AttestationRecordForValidator(ctx, validatorIdx, minTarget)
+	existingAttWrapper, err := recordReader.AttestationRecordForValidator(ctx, validatorIdx, minTarget)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not get existing attestation record at target %d", minTarget)
 	}
@@ -233,74 +400,48 @@ func (m *MinSpanChunksSlice) CheckSlashable(
 
 	surroundingVotesTotal.Inc()
 
-	// Both attestations should have the same type. If not, we convert both to Electra attestations.
-	unifyAttWrapperVersion(existingAttWrapper, incomingAttWrapper)
-
-	postElectra := existingAttWrapper.IndexedAttestation.Version() >= version.Electra
-	if postElectra {
-		existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
-		if !ok {
-			return nil, fmt.Errorf(
-				"existing attestation has wrong type (expected %T, got %T)",
-				&ethpb.IndexedAttestationElectra{},
-				existingAttWrapper.IndexedAttestation,
-			)
-		}
-		incoming, ok := incomingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
-		if !ok {
-			return nil, fmt.Errorf(
-				"incoming attestation has wrong type (expected %T, got %T)",
-				&ethpb.IndexedAttestationElectra{},
-				incomingAttWrapper.IndexedAttestation,
-			)
-		}
-		slashing := &ethpb.AttesterSlashingElectra{
-			Attestation_1: existing,
-			Attestation_2: incoming,
-		}
-
-		// Ensure the attestation with the lower data root is the first attestation.
-		if bytes.Compare(existingAttWrapper.DataRoot[:], incomingAttWrapper.DataRoot[:]) > 0 {
-			slashing = &ethpb.AttesterSlashingElectra{
-				Attestation_1: incoming,
-				Attestation_2: existing,
-			}
-		}
-
-		return slashing, nil
+	slashing, err := newAttesterSlashing(existingAttWrapper, incomingAttWrapper)
+	if err != nil {
+		return nil, err
 	}
 
-	existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
-	if !ok {
-		return nil, fmt.Errorf(
-			"existing attestation has wrong type (expected %T, got %T)",
-			&ethpb.IndexedAttestation{},
-			existingAttWrapper.IndexedAttestation,
-		)
-	}
-	incoming, ok := incomingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
-	if !ok {
-		return nil, fmt.Errorf(
-			"incoming attestation has wrong type (expected %T, got %T)",
-			&ethpb.IndexedAttestation{},
-			incomingAttWrapper.IndexedAttestation,
-		)
-	}
+	return &surroundVote{
+		slashing:           slashing,
+		spanTarget:         minTarget,
+		existingAttWrapper: existingAttWrapper,
+	}, nil
+}
 
-	slashing := &ethpb.AttesterSlashing{
-		Attestation_1: existing,
-		Attestation_2: incoming,
-	}
+// CheckSlashableBatch checks, for a validator index, whether each of the incoming attestations
+// is slashable depending on the min span chunks stored in the database. Each chunk is loaded
+// once, whatever the number of attestations with a source epoch within it. Only the parameters
+// of the receiver are used, not its data. See `CheckSlashable` for more details.
+func (m *MinSpanChunksSlice) CheckSlashableBatch(
+	ctx context.Context,
+	db ChunkReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrappers []*slashertypes.IndexedAttestationWrapper,
+) ([]ethpb.AttSlashing, error) {
+	return checkSlashableBatch(ctx, m.params, slashertypes.MinSpan, db, validatorIdx, incomingAttWrappers)
+}
 
-	// Ensure the attestation with the lower data root is the first attestation.
-	if bytes.Compare(existingAttWrapper.DataRoot[:], incomingAttWrapper.DataRoot[:]) > 0 {
-		slashing = &ethpb.AttesterSlashing{
-			Attestation_1: incoming,
-			Attestation_2: existing,
-		}
+// CheckSlashableDetailed behaves as `CheckSlashable`, but also returns the min span target
+// epoch which triggered the detection. It returns a nil result if the attestation is not slashable.
+func (m *MinSpanChunksSlice) CheckSlashableDetailed(
+	ctx context.Context,
+	recordReader AttestationRecordReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (*SlashableResult, error) {
+	vote, err := m.checkSlashable(ctx, recordReader, validatorIdx, incomingAttWrapper)
+	if err != nil || vote == nil {
+		return nil, err
 	}
 
-	return slashing, nil
+	return &SlashableResult{
+		Slashing:  vote.slashing,
+		MinTarget: vote.spanTarget,
+	}, nil
 }
 
 // CheckSlashable takes in a validator index and an incoming attestation
@@ -316,10 +457,34 @@ func (m *MinSpanChunksSlice) CheckSlashable(
 // to be confident of a slashable offense.
 func (m *MaxSpanChunksSlice) CheckSlashable(
 	ctx context.Context,
-	slasherDB db.SlasherDatabase,
+	recordReader AttestationRecordReader,
 	validatorIdx primitives.ValidatorIndex,
 	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
 ) (ethpb.AttSlashing, error) {
+	vote, err := m.checkSlashable(ctx, recordReader, validatorIdx, incomingAttWrapper)
+	if err != nil || vote == nil {
+		return nil, err
+	}
+
+	return vote.slashing, nil
+}
+
+// Checks if the incoming attestation is slashable as `CheckSlashable` does, and returns
+// the detected surround vote, or nil if the attestation is not slashable.
+func (m *MaxSpanChunksSlice) checkSlashable(
+	ctx context.Context,
+	recordReader AttestationRecordReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (*surroundVote, error) {
+	defer prometheus.NewTimer(maxSpanCheckSlashableSeconds).ObserveDuration()
+
+	// Span distances are computed as `target - source`, so a malformed attestation would
+	// produce nonsensical spans.
+	if err := validateAttestationIntegrity(incomingAttWrapper.IndexedAttestation); err != nil {
+		return nil, errors.Wrap(err, "invalid attestation")
+	}
+
 	sourceEpoch := incomingAttWrapper.IndexedAttestation.GetData().Source.Epoch
 	targetEpoch := incomingAttWrapper.IndexedAttestation.GetData().Target.Epoch
 
@@ -336,7 +501,7 @@ func (m *MaxSpanChunksSlice) CheckSlashable(
 	}
 
 	// The incoming attestation is surrounded by an existing one.
-	existingAttWrapper, err := slasherDB.AttestationRecordForValidator(ctx, validatorIdx, maxTarget)
+	existingAttWrapper, err := recordReader.AttestationRecordForValidator(ctx, validatorIdx, maxTarget)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not get existing attestation record at target %d", maxTarget)
 	}
@@ -366,74 +531,48 @@ func (m *MaxSpanChunksSlice) CheckSlashable(
 
 	surroundedVotesTotal.Inc()
 
-	// Both attestations should have the same type. If not, we convert the non-Electra attestation into an Electra attestation.
-	unifyAttWrapperVersion(existingAttWrapper, incomingAttWrapper)
-
-	postElectra := existingAttWrapper.IndexedAttestation.Version() >= version.Electra
-	if postElectra {
-		existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
-		if !ok {
-			return nil, fmt.Errorf(
-				"existing attestation has wrong type (expected %T, got %T)",
-				&ethpb.IndexedAttestationElectra{},
-				existingAttWrapper.IndexedAttestation,
-			)
-		}
-		incoming, ok := incomingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
-		if !ok {
-			return nil, fmt.Errorf(
-				"incoming attestation has wrong type (expected %T, got %T)",
-				&ethpb.IndexedAttestationElectra{},
-				incomingAttWrapper.IndexedAttestation,
-			)
-		}
-		slashing := &ethpb.AttesterSlashingElectra{
-			Attestation_1: existing,
-			Attestation_2: incoming,
-		}
-
-		// Ensure the attestation with the lower data root is the first attestation.
-		if bytes.Compare(existingAttWrapper.DataRoot[:], incomingAttWrapper.DataRoot[:]) > 0 {
-			slashing = &ethpb.AttesterSlashingElectra{
-				Attestation_1: incoming,
-				Attestation_2: existing,
-			}
-		}
-
-		return slashing, nil
+	slashing, err := newAttesterSlashing(existingAttWrapper, incomingAttWrapper)
+	if err != nil {
+		return nil, err
 	}
 
-	existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
-	if !ok {
-		return nil, fmt.Errorf(
-			"existing attestation has wrong type (expected %T, got %T)",
-			&ethpb.IndexedAttestation{},
-			existingAttWrapper.IndexedAttestation,
-		)
-	}
-	incoming, ok := incomingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
-	if !ok {
-		return nil, fmt.Errorf(
-			"incoming attestation has wrong type (expected %T, got %T)",
-			&ethpb.IndexedAttestation{},
-			incomingAttWrapper.IndexedAttestation,
-		)
-	}
+	return &surroundVote{
+		slashing:           slashing,
+		spanTarget:         maxTarget,
+		existingAttWrapper: existingAttWrapper,
+	}, nil
+}
 
-	slashing := &ethpb.AttesterSlashing{
-		Attestation_1: existing,
-		Attestation_2: incoming,
-	}
+// CheckSlashableBatch checks, for a validator index, whether each of the incoming attestations
+// is slashable depending on the max span chunks stored in the database. Each chunk is loaded
+// once, whatever the number of attestations with a source epoch within it. Only the parameters
+// of the receiver are used, not its data. See `CheckSlashable` for more details.
+func (m *MaxSpanChunksSlice) CheckSlashableBatch(
+	ctx context.Context,
+	db ChunkReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrappers []*slashertypes.IndexedAttestationWrapper,
+) ([]ethpb.AttSlashing, error) {
+	return checkSlashableBatch(ctx, m.params, slashertypes.MaxSpan, db, validatorIdx, incomingAttWrappers)
+}
 
-	// Ensure the attestation with the lower data root is the first attestation.
-	if bytes.Compare(existingAttWrapper.DataRoot[:], incomingAttWrapper.DataRoot[:]) > 0 {
-		slashing = &ethpb.AttesterSlashing{
-			Attestation_1: incoming,
-			Attestation_2: existing,
-		}
+// CheckSlashableDetailed behaves as `CheckSlashable`, but also returns the max span target
+// epoch which triggered the detection. It returns a nil result if the attestation is not slashable.
+func (m *MaxSpanChunksSlice) CheckSlashableDetailed(
+	ctx context.Context,
+	recordReader AttestationRecordReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (*SlashableResult, error) {
+	vote, err := m.checkSlashable(ctx, recordReader, validatorIdx, incomingAttWrapper)
+	if err != nil || vote == nil {
+		return nil, err
 	}
 
-	return slashing, nil
+	return &SlashableResult{
+		Slashing:  vote.slashing,
+		MaxTarget: vote.spanTarget,
+	}, nil
 }
 
 // Update a min span chunk for a validator index starting at the current epoch, e_c, then updating
@@ -495,6 +634,37 @@ func (m *MinSpanChunksSlice) Update(
 	validatorIndex primitives.ValidatorIndex,
 	startEpoch,
 	newTargetEpoch primitives.Epoch,
+) (keepGoing bool, err error) {
+	defer prometheus.NewTimer(minSpanChunkUpdateSeconds).ObserveDuration()
+
+	return m.update(chunkIndex, currentEpoch, validatorIndex, startEpoch, newTargetEpoch, nil)
+}
+
+// UpdateDryRun reports the epochs a call to `Update` with the same arguments would modify in the
+// min span chunks slice for the validator index, as well as the `keepGoing` flag it would return,
+// without modifying the chunk data.
+func (m *MinSpanChunksSlice) UpdateDryRun(
+	chunkIndex uint64,
+	currentEpoch primitives.Epoch,
+	validatorIndex primitives.ValidatorIndex,
+	startEpoch,
+	newTargetEpoch primitives.Epoch,
+) (epochs []primitives.Epoch, keepGoing bool, err error) {
+	clone := m.Clone().(*MinSpanChunksSlice)
+	keepGoing, err = clone.update(chunkIndex, currentEpoch, validatorIndex, startEpoch, newTargetEpoch, func(epoch primitives.Epoch) {
+		epochs = append(epochs, epoch)
+	})
+	return
+}
+
+// update implements `Update`, calling `onUpdate`, if not nil, with every epoch it modifies.
+func (m *MinSpanChunksSlice) update(
+	chunkIndex uint64,
+	currentEpoch primitives.Epoch,
+	validatorIndex primitives.ValidatorIndex,
+	startEpoch,
+	newTargetEpoch primitives.Epoch,
+	onUpdate func(epoch primitives.Epoch),
 ) (keepGoing bool, err error) {
 	// The lowest epoch we need to update.
 	minEpoch := primitives.Epoch(0)
@@ -519,6 +689,14 @@ func (m *MinSpanChunksSlice) Update(
 				err = errors.Wrapf(err, "could not set chunk data at epoch %d", epochInChunk)
 				return
 			}
+
+			if err = selfCheckChunkDataAtEpoch(m.params, m.data, validatorIndex, epochInChunk, newTargetEpoch); err != nil {
+				return
+			}
+
+			if onUpdate != nil {
+				onUpdate(epochInChunk)
+			}
 		} else {
 			// We can stop because spans are guaranteed to be minimums and
 			// if we did not meet the minimum condition, there is nothing to update.
@@ -545,6 +723,37 @@ func (m *MaxSpanChunksSlice) Update(
 	validatorIndex primitives.ValidatorIndex,
 	startEpoch,
 	newTargetEpoch primitives.Epoch,
+) (keepGoing bool, err error) {
+	defer prometheus.NewTimer(maxSpanChunkUpdateSeconds).ObserveDuration()
+
+	return m.update(chunkIndex, currentEpoch, validatorIndex, startEpoch, newTargetEpoch, nil)
+}
+
+// UpdateDryRun reports the epochs a call to `Update` with the same arguments would modify in the
+// max span chunks slice for the validator index, as well as the `keepGoing` flag it would return,
+// without modifying the chunk data.
+func (m *MaxSpanChunksSlice) UpdateDryRun(
+	chunkIndex uint64,
+	currentEpoch primitives.Epoch,
+	validatorIndex primitives.ValidatorIndex,
+	startEpoch,
+	newTargetEpoch primitives.Epoch,
+) (epochs []primitives.Epoch, keepGoing bool, err error) {
+	clone := m.Clone().(*MaxSpanChunksSlice)
+	keepGoing, err = clone.update(chunkIndex, currentEpoch, validatorIndex, startEpoch, newTargetEpoch, func(epoch primitives.Epoch) {
+		epochs = append(epochs, epoch)
+	})
+	return
+}
+
+// update implements `Update`, calling `onUpdate`, if not nil, with every epoch it modifies.
+func (m *MaxSpanChunksSlice) update(
+	chunkIndex uint64,
+	currentEpoch primitives.Epoch,
+	validatorIndex primitives.ValidatorIndex,
+	startEpoch,
+	newTargetEpoch primitives.Epoch,
+	onUpdate func(epoch primitives.Epoch),
 ) (keepGoing bool, err error) {
 	epochInChunk := startEpoch
 	// We go down the chunk for the validator, updating every value starting at startEpoch up to
@@ -564,6 +773,14 @@ func (m *MaxSpanChunksSlice) Update(
 				err = errors.Wrapf(err, "could not set chunk data at epoch %d", epochInChunk)
 				return
 			}
+
+			if err = selfCheckChunkDataAtEpoch(m.params, m.data, validatorIndex, epochInChunk, newTargetEpoch); err != nil {
+				return
+			}
+
+			if onUpdate != nil {
+				onUpdate(epochInChunk)
+			}
 		} else {
 			// We can stop because spans are guaranteed to be maxima and
 			// if we did not meet the condition, there is nothing to update.
@@ -669,15 +886,15 @@ func (m *MaxSpanChunksSlice) NextChunkStartEpoch(startEpoch primitives.Epoch) pr
 func chunkDataAtEpoch(
 	params *Parameters, chunk []uint16, validatorIdx primitives.ValidatorIndex, epoch primitives.Epoch,
 ) (primitives.Epoch, error) {
-	requiredLen := params.chunkSize * params.validatorChunkSize
+	requiredLen := params.chunkLength()
 	if uint64(len(chunk)) != requiredLen {
 		return 0, fmt.Errorf("chunk has wrong length, %d, expected %d", len(chunk), requiredLen)
 	}
 	cellIdx := params.cellIndex(validatorIdx, epoch)
-	if cellIdx >= uint64(len(chunk)) {
+	if (cellIdx+1)*params.elementWords() > uint64(len(chunk)) {
 		return 0, fmt.Errorf("cell index %d out of bounds (len(chunk) = %d)", cellIdx, len(chunk))
 	}
-	distance := chunk[cellIdx]
+	distance := chunkElement(params, chunk, cellIdx)
 	return epoch.Add(uint64(distance)), nil
 }
 
@@ -692,13 +909,42 @@ func setChunkDataAtEpoch(
 	epochInChunk,
 	targetEpoch primitives.Epoch,
 ) error {
-	distance, err := epochDistance(targetEpoch, epochInChunk)
+	distance, err := epochDistance(params, targetEpoch, epochInChunk)
 	if err != nil {
 		return err
 	}
 	return setChunkRawDistance(params, chunk, validatorIdx, epochInChunk, distance)
 }
 
+// When the slasher self-check is enabled, reads back the value at a specific index in a chunk
+// for a validator index and epoch, and returns an error if it does not correspond to the expected
+// target epoch. This is a safety net catching chunk arithmetic inconsistencies during development and fuzzing.
+func selfCheckChunkDataAtEpoch(
+	params *Parameters,
+	chunk []uint16,
+	validatorIdx primitives.ValidatorIndex,
+	epochInChunk,
+	expectedTargetEpoch primitives.Epoch,
+) error {
+	if !features.Get().EnableSlasherSelfCheck {
+		return nil
+	}
+
+	targetEpoch, err := chunkDataAtEpoch(params, chunk, validatorIdx, epochInChunk)
+	if err != nil {
+		return errors.Wrapf(err, "slasher self-check failed: could not read back chunk data at epoch %d", epochInChunk)
+	}
+
+	if targetEpoch != expectedTargetEpoch {
+		return errors.Errorf(
+			"slasher self-check failed: read back target epoch %d at epoch %d for validator %d, expected %d",
+			targetEpoch, epochInChunk, validatorIdx, expectedTargetEpoch,
+		)
+	}
+
+	return nil
+}
+
 // Updates the value at a specific index in a chunk for a validator index and epoch
 // to a specified, raw distance value.
 func setChunkRawDistance(
@@ -706,22 +952,239 @@ func setChunkRawDistance(
 	chunk []uint16,
 	validatorIdx primitives.ValidatorIndex,
 	epochInChunk primitives.Epoch,
-	distance uint16,
+	distance uint32,
 ) error {
 	cellIdx := params.cellIndex(validatorIdx, epochInChunk)
-	if cellIdx >= uint64(len(chunk)) {
+	if (cellIdx+1)*params.elementWords() > uint64(len(chunk)) {
 		return fmt.Errorf("cell index %d out of bounds (len(chunk) = %d)", cellIdx, len(chunk))
 	}
-	chunk[cellIdx] = distance
+	setChunkElement(params, chunk, cellIdx, distance)
 	return nil
 }
 
+// Returns the element at a specific cell index in a chunk. Elements wider than 16 bits
+// are stored on consecutive uint16 words, the least significant word first.
+func chunkElement(params *Parameters, chunk []uint16, cellIdx uint64) uint32 {
+	if params.ElementWidth() == ElementWidth32 {
+		return uint32(chunk[2*cellIdx]) | uint32(chunk[2*cellIdx+1])<<16
+	}
+	return uint32(chunk[cellIdx])
+}
+
+// Sets the element at a specific cell index in a chunk. Values are truncated to the element width.
+func setChunkElement(params *Parameters, chunk []uint16, cellIdx uint64, value uint32) {
+	if params.ElementWidth() == ElementWidth32 {
+		chunk[2*cellIdx] = uint16(value)
+		chunk[2*cellIdx+1] = uint16(value >> 16)
+		return
+	}
+	chunk[cellIdx] = uint16(value)
+}
+
 // Computes a distance between two epochs. Given the result stored in
-// min/max spans is at maximum WEAK_SUBJECTIVITY_PERIOD, we are guaranteed the
-// distance can be represented as a uint16 safely.
-func epochDistance(epoch, baseEpoch primitives.Epoch) (uint16, error) {
+// min/max spans is at maximum the history length, we are guaranteed the
+// distance can be represented safely as long as the element width is large
+// enough for the history length.
+func epochDistance(params *Parameters, epoch, baseEpoch primitives.Epoch) (uint32, error) {
 	if baseEpoch > epoch {
 		return 0, fmt.Errorf("base epoch %d cannot be less than epoch %d", baseEpoch, epoch)
 	}
-	return uint16(epoch.Sub(uint64(baseEpoch))), nil
+	distance := uint64(epoch.Sub(uint64(baseEpoch)))
+	if params.ElementWidth() == ElementWidth32 {
+		return uint32(distance), nil
+	}
+	return uint32(uint16(distance)), nil
+}
+
+// Sets every element of a chunk to the given value. Rather than assigning
+// each cell individually, the already filled prefix of the chunk is copied
+// onto the remainder, doubling the filled length at every step.
+func fillChunk(chunk []uint16, value uint16) {
+	if len(chunk) == 0 {
+		return
+	}
+	chunk[0] = value
+	for filled := 1; filled < len(chunk); filled *= 2 {
+		copy(chunk[filled:], chunk[:filled])
+	}
+}
+
+// Returns a new chunk whose elements are the result of `merge` applied to the corresponding
+// elements of two chunks. Both chunks must have been built with identical parameters.
+func mergeChunks(aParams, bParams *Parameters, a, b []uint16, merge func(x, y uint32) uint32) ([]uint16, error) {
+	if aParams == nil || bParams == nil {
+		return nil, errors.New("nil parameters")
+	}
+	if aParams.chunkSize != bParams.chunkSize ||
+		aParams.validatorChunkSize != bParams.validatorChunkSize ||
+		aParams.historyLength != bParams.historyLength ||
+		aParams.ElementWidth() != bParams.ElementWidth() {
+		return nil, fmt.Errorf("parameters differ: %s and %s", aParams, bParams)
+	}
+
+	requiredLen := aParams.chunkLength()
+	if uint64(len(a)) != requiredLen || uint64(len(b)) != requiredLen {
+		return nil, fmt.Errorf("chunks have wrong lengths, %d and %d, expected %d", len(a), len(b), requiredLen)
+	}
+
+	merged := make([]uint16, requiredLen)
+	cellsCount := aParams.chunkSize * aParams.validatorChunkSize
+	for cellIdx := uint64(0); cellIdx < cellsCount; cellIdx++ {
+		value := merge(chunkElement(aParams, a, cellIdx), chunkElement(aParams, b, cellIdx))
+		setChunkElement(aParams, merged, cellIdx, value)
+	}
+	return merged, nil
+}
+
+// Returns a chunk of length C*K for C = chunkSize and K = validatorChunkSize
+// with every element set to the given value, whatever the element width.
+func filledChunk(params *Parameters, value uint16) []uint16 {
+	data := make([]uint16, params.chunkLength())
+	if params.ElementWidth() == ElementWidth16 {
+		fillChunk(data, value)
+		return data
+	}
+
+	cellsCount := params.chunkSize * params.validatorChunkSize
+	for cellIdx := uint64(0); cellIdx < cellsCount; cellIdx++ {
+		setChunkElement(params, data, cellIdx, uint32(value))
+	}
+	return data
+}
+
+// Checks whether each of the incoming attestations of a validator is slashable against the
+// span chunks of the given kind. Attestations are checked by increasing target epoch, and the
+// chunks containing their source epochs are loaded from the database in a single read. The
+// returned slashings are in the order of the incoming attestations.
+func checkSlashableBatch(
+	ctx context.Context,
+	params *Parameters,
+	kind slashertypes.ChunkKind,
+	db ChunkReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrappers []*slashertypes.IndexedAttestationWrapper,
+) ([]ethpb.AttSlashing, error) {
+	if len(incomingAttWrappers) == 0 {
+		return []ethpb.AttSlashing{}, nil
+	}
+
+	for i, incomingAttWrapper := range incomingAttWrappers {
+		if err := validateAttestationIntegrity(incomingAttWrapper.IndexedAttestation); err != nil {
+			return nil, errors.Wrapf(err, "invalid attestation %d", i)
+		}
+	}
+
+	// Sort the attestations by target epoch.
+	order := make([]int, len(incomingAttWrappers))
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, func(i, j int) int {
+		return cmp.Compare(
+			incomingAttWrappers[i].IndexedAttestation.GetData().Target.Epoch,
+			incomingAttWrappers[j].IndexedAttestation.GetData().Target.Epoch,
+		)
+	})
+
+	// Determine the chunk indexes containing the source epochs.
+	chunkIndexes := make([]uint64, 0)
+	chunkIndexesMap := make(map[uint64]bool)
+	for _, incomingAttWrapper := range incomingAttWrappers {
+		chunkIndex := params.chunkIndex(incomingAttWrapper.IndexedAttestation.GetData().Source.Epoch)
+		if !chunkIndexesMap[chunkIndex] {
+			chunkIndexesMap[chunkIndex] = true
+			chunkIndexes = append(chunkIndexes, chunkIndex)
+		}
+	}
+
+	validatorChunkIndex := params.validatorChunkIndex(validatorIdx)
+	chunkKeys := make([][]byte, 0, len(chunkIndexes))
+	for _, chunkIndex := range chunkIndexes {
+		chunkKeys = append(chunkKeys, params.flatSliceID(validatorChunkIndex, chunkIndex))
+	}
+
+	rawChunks, chunksExist, err := db.LoadSlasherChunks(ctx, kind, chunkKeys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load %s chunks", kind)
+	}
+
+	if len(rawChunks) != len(chunkIndexes) || len(chunksExist) != len(chunkIndexes) {
+		return nil, errors.Errorf("expected %d chunks, got %d", len(chunkIndexes), len(rawChunks))
+	}
+
+	// Initialize the chunks. Missing chunks are empty.
+	chunkByChunkIndex := make(map[uint64]Chunker, len(chunkIndexes))
+	for i, chunkIndex := range chunkIndexes {
+		var chunk Chunker
+
+		switch kind {
+		case slashertypes.MinSpan:
+			if !chunksExist[i] {
+				chunk = EmptyMinSpanChunksSlice(params)
+				break
+			}
+			chunk, err = MinChunkSpansSliceFrom(params, rawChunks[i])
+		case slashertypes.MaxSpan:
+			if !chunksExist[i] {
+				chunk = EmptyMaxSpanChunksSlice(params)
+				break
+			}
+			chunk, err = MaxChunkSpansSliceFrom(params, rawChunks[i])
+		default:
+			return nil, errors.Errorf("unknown chunk kind %s", kind)
+		}
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not initialize %s chunk for chunk index %d", kind, chunkIndex)
+		}
+
+		chunkByChunkIndex[chunkIndex] = chunk
+	}
+
+	slashings := make([]ethpb.AttSlashing, len(incomingAttWrappers))
+	for _, i := range order {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		incomingAttWrapper := incomingAttWrappers[i]
+		chunk := chunkByChunkIndex[params.chunkIndex(incomingAttWrapper.IndexedAttestation.GetData().Source.Epoch)]
+
+		slashing, err := chunk.CheckSlashable(ctx, db, validatorIdx, incomingAttWrapper)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not check if attestation %d is slashable", i)
+		}
+
+		slashings[i] = slashing
+	}
+
+	return slashings, nil
+}
+
+// CheckDoubleVote checks if the incoming attestation is a double vote for a validator index,
+// that is if the database contains an attestation record of the validator for the same
+// target epoch but with a different data root, and returns the corresponding slashing if so.
+// Unlike `CheckSlashable`, the result does not depend on the data within span chunks, so it
+// allows to detect both slashing categories within a single code path during chunk processing.
+// Updating the double votes metric is left to the caller.
+func CheckDoubleVote(
+	ctx context.Context,
+	recordReader AttestationRecordReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (ethpb.AttSlashing, error) {
+	targetEpoch := incomingAttWrapper.IndexedAttestation.GetData().Target.Epoch
+
+	existingAttWrapper, err := recordReader.AttestationRecordForValidator(ctx, validatorIdx, targetEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get existing attestation record at target %d", targetEpoch)
+	}
+
+	if existingAttWrapper == nil || existingAttWrapper.DataRoot == incomingAttWrapper.DataRoot {
+		// There is no other attestation for this `validator index x epoch` combination.
+		return nil, nil
+	}
+
+	return newAttesterSlashing(existingAttWrapper, incomingAttWrapper)
 }
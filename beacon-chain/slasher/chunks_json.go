@@ -0,0 +1,104 @@
+package slasher
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+)
+
+// Version of the JSON document produced when serializing span chunks slices.
+// It must be increased whenever the format of the document changes.
+const chunkJSONVersion = 1
+
+// Human-readable representation of a min or max span chunks slice, used for debugging.
+type chunkJSON struct {
+	Version            int      `json:"version"`
+	Kind               string   `json:"kind"`
+	ChunkSize          uint64   `json:"chunk_size"`
+	ValidatorChunkSize uint64   `json:"validator_chunk_size"`
+	HistoryLength      uint64   `json:"history_length"`
+	ElementWidth       uint8    `json:"element_width"`
+	Data               []uint16 `json:"data"`
+}
+
+// MarshalJSON serializes the parameters and data of the min span chunks slice as a versioned JSON document.
+func (m *MinSpanChunksSlice) MarshalJSON() ([]byte, error) {
+	return marshalChunkJSON(slashertypes.MinSpan, m.params, m.data)
+}
+
+// UnmarshalJSON deserializes a min span chunks slice from a JSON document produced by MarshalJSON.
+// The data length is validated against the deserialized parameters.
+func (m *MinSpanChunksSlice) UnmarshalJSON(enc []byte) error {
+	params, data, err := unmarshalChunkJSON(slashertypes.MinSpan, enc)
+	if err != nil {
+		return err
+	}
+
+	m.params, m.data = params, data
+	return nil
+}
+
+// MarshalJSON serializes the parameters and data of the max span chunks slice as a versioned JSON document.
+func (m *MaxSpanChunksSlice) MarshalJSON() ([]byte, error) {
+	return marshalChunkJSON(slashertypes.MaxSpan, m.params, m.data)
+}
+
+// UnmarshalJSON deserializes a max span chunks slice from a JSON document produced by MarshalJSON.
+// The data length is validated against the deserialized parameters.
+func (m *MaxSpanChunksSlice) UnmarshalJSON(enc []byte) error {
+	params, data, err := unmarshalChunkJSON(slashertypes.MaxSpan, enc)
+	if err != nil {
+		return err
+	}
+
+	m.params, m.data = params, data
+	return nil
+}
+
+func marshalChunkJSON(kind slashertypes.ChunkKind, params *Parameters, data []uint16) ([]byte, error) {
+	if params == nil {
+		return nil, errors.New("nil parameters")
+	}
+
+	return json.Marshal(&chunkJSON{
+		Version:            chunkJSONVersion,
+		Kind:               kind.String(),
+		ChunkSize:          params.chunkSize,
+		ValidatorChunkSize: params.validatorChunkSize,
+		HistoryLength:      uint64(params.historyLength),
+		ElementWidth:       uint8(params.ElementWidth()),
+		Data:               data,
+	})
+}
+
+func unmarshalChunkJSON(kind slashertypes.ChunkKind, enc []byte) (*Parameters, []uint16, error) {
+	var decoded chunkJSON
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode chunk")
+	}
+
+	if decoded.Version != chunkJSONVersion {
+		return nil, nil, errors.Errorf("unsupported chunk version %d, expected %d", decoded.Version, chunkJSONVersion)
+	}
+
+	if decoded.Kind != kind.String() {
+		return nil, nil, errors.Errorf("wrong chunk kind %s, expected %s", decoded.Kind, kind)
+	}
+
+	params, err := NewParameters(
+		decoded.ChunkSize,
+		decoded.ValidatorChunkSize,
+		decoded.HistoryLength,
+		ElementWidth(decoded.ElementWidth),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid chunk parameters")
+	}
+
+	if requiredLen := params.chunkLength(); uint64(len(decoded.Data)) != requiredLen {
+		return nil, nil, errors.Errorf("chunk has wrong length, %d, expected %d", len(decoded.Data), requiredLen)
+	}
+
+	return params, decoded.Data, nil
+}
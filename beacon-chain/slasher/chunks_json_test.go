@@ -0,0 +1,86 @@
+package slasher
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSpanChunksSlice_JSONRoundTrip(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 3,
+		historyLength:      4,
+	}
+
+	minChunk := EmptyMinSpanChunksSlice(params)
+	require.NoError(t, minChunk.SetDataAtEpoch(1, 1, 3))
+
+	enc, err := json.Marshal(minChunk)
+	require.NoError(t, err)
+
+	decodedMin := &MinSpanChunksSlice{}
+	require.NoError(t, json.Unmarshal(enc, decodedMin))
+	require.DeepEqual(t, minChunk.Chunk(), decodedMin.Chunk())
+	require.Equal(t, params.String(), decodedMin.params.String())
+
+	wideParams, err := params.WithElementWidth(ElementWidth32)
+	require.NoError(t, err)
+
+	maxChunk := EmptyMaxSpanChunksSlice(wideParams)
+	require.NoError(t, maxChunk.SetDataAtEpoch(2, 0, 70000))
+
+	enc, err = json.Marshal(maxChunk)
+	require.NoError(t, err)
+
+	decodedMax := &MaxSpanChunksSlice{}
+	require.NoError(t, json.Unmarshal(enc, decodedMax))
+	require.DeepEqual(t, maxChunk.Chunk(), decodedMax.Chunk())
+	require.Equal(t, ElementWidth32, decodedMax.params.ElementWidth())
+}
+
+func TestSpanChunksSlice_UnmarshalJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		enc     string
+		wantErr string
+	}{
+		{
+			name:    "malformed",
+			enc:     `{"version":`,
+			wantErr: "could not decode chunk",
+		},
+		{
+			name:    "unsupported version",
+			enc:     `{"version":2,"kind":"minspan","chunk_size":2,"validator_chunk_size":1,"history_length":4,"element_width":16,"data":[0,0]}`,
+			wantErr: "unsupported chunk version 2",
+		},
+		{
+			name:    "wrong kind",
+			enc:     `{"version":1,"kind":"maxspan","chunk_size":2,"validator_chunk_size":1,"history_length":4,"element_width":16,"data":[0,0]}`,
+			wantErr: "wrong chunk kind maxspan",
+		},
+		{
+			name:    "invalid parameters",
+			enc:     `{"version":1,"kind":"minspan","chunk_size":0,"validator_chunk_size":1,"history_length":4,"element_width":16,"data":[]}`,
+			wantErr: "chunk size must be greater than 0",
+		},
+		{
+			name:    "invalid element width",
+			enc:     `{"version":1,"kind":"minspan","chunk_size":2,"validator_chunk_size":1,"history_length":4,"element_width":8,"data":[0,0]}`,
+			wantErr: "unsupported element width 8",
+		},
+		{
+			name:    "wrong data length",
+			enc:     `{"version":1,"kind":"minspan","chunk_size":2,"validator_chunk_size":1,"history_length":4,"element_width":32,"data":[0,0]}`,
+			wantErr: "chunk has wrong length, 2, expected 4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&MinSpanChunksSlice{}).UnmarshalJSON([]byte(tt.enc))
+			require.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
@@ -1,13 +1,16 @@
 package slasher
 
 import (
+	"bytes"
 	"context"
 	"math"
 	"reflect"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
@@ -18,8 +21,20 @@ import (
 var (
 	_ = Chunker(&MinSpanChunksSlice{})
 	_ = Chunker(&MaxSpanChunksSlice{})
+	_ = AttestationRecordReader(db.SlasherDatabase(nil))
+	_ = AttestationRecordReader(memoryAttestationRecordReader{})
+	_ = ChunkReader(db.SlasherDatabase(nil))
 )
 
+// Memory-backed attestation record reader, keyed by validator index and target epoch.
+type memoryAttestationRecordReader map[primitives.ValidatorIndex]map[primitives.Epoch]*slashertypes.IndexedAttestationWrapper
+
+func (r memoryAttestationRecordReader) AttestationRecordForValidator(
+	_ context.Context, validatorIdx primitives.ValidatorIndex, targetEpoch primitives.Epoch,
+) (*slashertypes.IndexedAttestationWrapper, error) {
+	return r[validatorIdx][targetEpoch], nil
+}
+
 func TestMinSpanChunksSlice_Chunk(t *testing.T) {
 	chunk := EmptyMinSpanChunksSlice(&Parameters{
 		chunkSize:          2,
@@ -38,14 +53,47 @@ func TestMaxSpanChunksSlice_Chunk(t *testing.T) {
 	require.DeepEqual(t, wanted, chunk.Chunk())
 }
 
+func TestSpanChunksSlice_Clone(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+
+	for _, chunk := range []Chunker{EmptyMinSpanChunksSlice(params), EmptyMaxSpanChunksSlice(params)} {
+		var clone Chunker
+		switch c := chunk.(type) {
+		case *MinSpanChunksSlice:
+			clone = c.Clone()
+		case *MaxSpanChunksSlice:
+			clone = c.Clone()
+		}
+
+		require.DeepEqual(t, chunk.Chunk(), clone.Chunk())
+
+		// Mutating the clone leaves the original unchanged.
+		clone.Chunk()[0] = 3
+		require.Equal(t, chunk.NeutralElement(), uint32(chunk.Chunk()[0]))
+		require.Equal(t, uint16(3), clone.Chunk()[0])
+
+		// Updating the clone leaves the original unchanged.
+		_, err := clone.Update(0, 1, 1, 1, 3)
+		require.NoError(t, err)
+		require.DeepNotEqual(t, chunk.Chunk(), clone.Chunk())
+		for _, value := range chunk.Chunk() {
+			require.Equal(t, chunk.NeutralElement(), uint32(value))
+		}
+	}
+}
+
 func TestMinSpanChunksSlice_NeutralElement(t *testing.T) {
 	chunk := EmptyMinSpanChunksSlice(&Parameters{})
-	require.Equal(t, uint16(math.MaxUint16), chunk.NeutralElement())
+	require.Equal(t, uint32(math.MaxUint16), chunk.NeutralElement())
 }
 
 func TestMaxSpanChunksSlice_NeutralElement(t *testing.T) {
 	chunk := EmptyMaxSpanChunksSlice(&Parameters{})
-	require.Equal(t, uint16(0), chunk.NeutralElement())
+	require.Equal(t, uint32(0), chunk.NeutralElement())
 }
 
 func TestMinSpanChunksSlice_MinChunkSpanFrom(t *testing.T) {
@@ -220,6 +268,322 @@ func TestMinSpanChunksSlice_CheckSlashable_DifferentVersions(t *testing.T) {
 	assert.NotNil(t, electraSlashing)
 }
 
+func TestSpanChunksSlice_CheckSlashableBatch(t *testing.T) {
+	ctx := context.Background()
+
+	// 2 epochs per chunk, 2 chunks worth of history.
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+	validatorIdx := primitives.ValidatorIndex(1)
+
+	saveChunk := func(t *testing.T, slasherDB db.SlasherDatabase, kind slashertypes.ChunkKind, chunkIdx uint64, chunk Chunker) {
+		key := params.flatSliceID(params.validatorChunkIndex(validatorIdx), chunkIdx)
+		require.NoError(t, slasherDB.SaveSlasherChunks(ctx, kind, [][]byte{key}, [][]uint16{chunk.Chunk()}))
+	}
+
+	t.Run("min span", func(t *testing.T) {
+		slasherDB := dbtest.SetupSlasherDB(t)
+
+		// Mark an attestation with (source 1, target 2) as attested, and save its record.
+		// The chunk index 0 holds the epochs 0 and 1, the chunk index 1 holds the epochs 2 and 3.
+		chunk0 := EmptyMinSpanChunksSlice(params)
+		_, err := chunk0.Update(0, 2, validatorIdx, 0, 2)
+		require.NoError(t, err)
+		saveChunk(t, slasherDB, slashertypes.MinSpan, 0, chunk0)
+
+		attRecord := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{uint64(validatorIdx)}, []byte{1})
+		require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{attRecord}))
+
+		// Attestations are not sorted by target epoch, and have source epochs in both chunks.
+		// The chunk index 1 is missing from the database, and is thus considered empty.
+		surroundingVote := createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, nil, nil)
+		att := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil)
+		laterAtt := createAttestationWrapperEmptySig(t, version.Phase0, 2, 3, nil, nil)
+		atts := []*slashertypes.IndexedAttestationWrapper{laterAtt, surroundingVote, att}
+
+		slashings, err := chunk0.CheckSlashableBatch(ctx, slasherDB, validatorIdx, atts)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(slashings))
+		require.Equal(t, nil, slashings[0])
+		require.Equal(t, false, reflect.ValueOf(slashings[1]).IsNil())
+		require.Equal(t, nil, slashings[2])
+
+		expected, err := chunk0.CheckSlashable(ctx, slasherDB, validatorIdx, surroundingVote)
+		require.NoError(t, err)
+		require.DeepEqual(t, expected, slashings[1])
+	})
+
+	t.Run("max span", func(t *testing.T) {
+		slasherDB := dbtest.SetupSlasherDB(t)
+
+		// Mark an attestation with (source 0, target 3) as attested, and save its record.
+		chunk0, chunk1 := EmptyMaxSpanChunksSlice(params), EmptyMaxSpanChunksSlice(params)
+		keepGoing, err := chunk0.Update(0, 3, validatorIdx, 1, 3)
+		require.NoError(t, err)
+		require.Equal(t, true, keepGoing)
+		_, err = chunk1.Update(1, 3, validatorIdx, 2, 3)
+		require.NoError(t, err)
+		saveChunk(t, slasherDB, slashertypes.MaxSpan, 0, chunk0)
+		saveChunk(t, slasherDB, slashertypes.MaxSpan, 1, chunk1)
+
+		attRecord := createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, []uint64{uint64(validatorIdx)}, []byte{1})
+		require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{attRecord}))
+
+		// Attestations are not sorted by target epoch, and have source epochs in both chunks.
+		laterAtt := createAttestationWrapperEmptySig(t, version.Phase0, 2, 3, nil, nil)
+		att := createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, nil, nil)
+		surroundedVote := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil)
+		atts := []*slashertypes.IndexedAttestationWrapper{laterAtt, att, surroundedVote}
+
+		slashings, err := chunk0.CheckSlashableBatch(ctx, slasherDB, validatorIdx, atts)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(slashings))
+		require.Equal(t, nil, slashings[0])
+		require.Equal(t, nil, slashings[1])
+		require.Equal(t, false, reflect.ValueOf(slashings[2]).IsNil())
+
+		expected, err := chunk0.CheckSlashable(ctx, slasherDB, validatorIdx, surroundedVote)
+		require.NoError(t, err)
+		require.DeepEqual(t, expected, slashings[2])
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		slashings, err := EmptyMinSpanChunksSlice(params).CheckSlashableBatch(ctx, nil, validatorIdx, nil)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(slashings))
+	})
+
+	t.Run("invalid attestation", func(t *testing.T) {
+		att := createAttestationWrapperEmptySig(t, version.Phase0, 3, 2, nil, nil)
+
+		_, err := EmptyMinSpanChunksSlice(params).CheckSlashableBatch(ctx, nil, validatorIdx, []*slashertypes.IndexedAttestationWrapper{att})
+		require.ErrorContains(t, "invalid attestation 0: source epoch 3 is not before target epoch 2", err)
+
+		_, err = EmptyMaxSpanChunksSlice(params).CheckSlashableBatch(ctx, nil, validatorIdx, []*slashertypes.IndexedAttestationWrapper{att})
+		require.ErrorContains(t, "invalid attestation 0: source epoch 3 is not before target epoch 2", err)
+	})
+}
+
+// Compares checking many attestations of a validator with `CheckSlashableBatch` against loading the
+// chunk of each attestation from the database and calling `CheckSlashable` on it.
+func BenchmarkCheckSlashableBatch(b *testing.B) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(b)
+	params := DefaultParams()
+	validatorIdx := primitives.ValidatorIndex(1)
+	validatorChunkIdx := params.validatorChunkIndex(validatorIdx)
+
+	// 256 attestations with source epochs spread over 16 chunks.
+	const attsCount = 256
+	atts := make([]*slashertypes.IndexedAttestationWrapper, 0, attsCount)
+	chunkKeys := make([][]byte, 0, attsCount/params.chunkSize)
+	chunks := make([][]uint16, 0, attsCount/params.chunkSize)
+	for epoch := primitives.Epoch(0); epoch < attsCount; epoch++ {
+		atts = append(atts, createAttestationWrapperEmptySig(b, version.Phase0, epoch, epoch+1, nil, nil))
+		if uint64(epoch)%params.chunkSize == 0 {
+			chunkKeys = append(chunkKeys, params.flatSliceID(validatorChunkIdx, params.chunkIndex(epoch)))
+			chunks = append(chunks, EmptyMinSpanChunksSlice(params).Chunk())
+		}
+	}
+
+	require.NoError(b, slasherDB.SaveSlasherChunks(ctx, slashertypes.MinSpan, chunkKeys, chunks))
+
+	b.Run("batch", func(b *testing.B) {
+		chunk := EmptyMinSpanChunksSlice(params)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, err := chunk.CheckSlashableBatch(ctx, slasherDB, validatorIdx, atts)
+			require.NoError(b, err)
+		}
+	})
+
+	b.Run("single", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, att := range atts {
+				key := params.flatSliceID(validatorChunkIdx, params.chunkIndex(att.IndexedAttestation.GetData().Source.Epoch))
+				rawChunks, _, err := slasherDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{key})
+				require.NoError(b, err)
+
+				chunk, err := MinChunkSpansSliceFrom(params, rawChunks[0])
+				require.NoError(b, err)
+
+				_, err = chunk.CheckSlashable(ctx, slasherDB, validatorIdx, att)
+				require.NoError(b, err)
+			}
+		}
+	})
+}
+
+func TestCheckDoubleVote(t *testing.T) {
+	ctx := context.Background()
+	validatorIdx := primitives.ValidatorIndex(1)
+
+	for _, v := range []int{version.Phase0, version.Electra} {
+		t.Run(version.String(v), func(t *testing.T) {
+			slasherDB := dbtest.SetupSlasherDB(t)
+
+			attRecord := createAttestationWrapperEmptySig(t, v, 0, 2, []uint64{uint64(validatorIdx)}, []byte{1})
+			require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{attRecord}))
+
+			// The same attestation is not a double vote.
+			sameVote := createAttestationWrapperEmptySig(t, v, 0, 2, nil, []byte{1})
+			slashing, err := CheckDoubleVote(ctx, slasherDB, validatorIdx, sameVote)
+			require.NoError(t, err)
+			require.Equal(t, nil, slashing)
+
+			// An attestation for another target epoch is not a double vote.
+			otherTarget := createAttestationWrapperEmptySig(t, v, 0, 3, nil, []byte{2})
+			slashing, err = CheckDoubleVote(ctx, slasherDB, validatorIdx, otherTarget)
+			require.NoError(t, err)
+			require.Equal(t, nil, slashing)
+
+			// An attestation from another validator is not a double vote.
+			doubleVote := createAttestationWrapperEmptySig(t, v, 0, 2, nil, []byte{2})
+			slashing, err = CheckDoubleVote(ctx, slasherDB, validatorIdx+1, doubleVote)
+			require.NoError(t, err)
+			require.Equal(t, nil, slashing)
+
+			// An attestation for the same target epoch with a different data root is a double vote.
+			slashing, err = CheckDoubleVote(ctx, slasherDB, validatorIdx, doubleVote)
+			require.NoError(t, err)
+			require.Equal(t, false, reflect.ValueOf(slashing).IsNil())
+			require.Equal(t, v, slashing.Version())
+
+			// The attestation with the lower data root is the first attestation.
+			first, second := attRecord, doubleVote
+			if bytes.Compare(attRecord.DataRoot[:], doubleVote.DataRoot[:]) > 0 {
+				first, second = doubleVote, attRecord
+			}
+			require.DeepEqual(t, first.IndexedAttestation.GetData(), slashing.FirstAttestation().GetData())
+			require.DeepEqual(t, second.IndexedAttestation.GetData(), slashing.SecondAttestation().GetData())
+		})
+	}
+}
+
+func TestSpanChunksSlice_CheckSlashableDetailed(t *testing.T) {
+	ctx := context.Background()
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	validatorIdx := primitives.ValidatorIndex(1)
+
+	t.Run("min span", func(t *testing.T) {
+		slasherDB := dbtest.SetupSlasherDB(t)
+
+		// Mark an attestation with (source 1, target 2) as attested, and save its record.
+		chunk := EmptyMinSpanChunksSlice(params)
+		_, err := chunk.Update(0, 2, validatorIdx, 2, 2)
+		require.NoError(t, err)
+
+		attRecord := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{uint64(validatorIdx)}, []byte{1})
+		require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{attRecord}))
+
+		result, err := chunk.CheckSlashableDetailed(ctx, slasherDB, validatorIdx, createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil))
+		require.NoError(t, err)
+		require.Equal(t, true, result == nil)
+
+		// The surrounding vote surrounds the attestation with target 2.
+		surroundingVote := createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, nil, nil)
+		result, err = chunk.CheckSlashableDetailed(ctx, slasherDB, validatorIdx, surroundingVote)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.Equal(t, false, reflect.ValueOf(result.Slashing).IsNil())
+		require.Equal(t, primitives.Epoch(2), result.MinTarget)
+		require.Equal(t, primitives.Epoch(0), result.MaxTarget)
+	})
+
+	t.Run("max span", func(t *testing.T) {
+		slasherDB := dbtest.SetupSlasherDB(t)
+
+		// Mark an attestation with (source 0, target 3) as attested, and save its record.
+		chunk := EmptyMaxSpanChunksSlice(params)
+		_, err := chunk.Update(0, 3, validatorIdx, 0, 3)
+		require.NoError(t, err)
+
+		attRecord := createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, []uint64{uint64(validatorIdx)}, []byte{1})
+		require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{attRecord}))
+
+		result, err := chunk.CheckSlashableDetailed(ctx, slasherDB, validatorIdx, createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, nil, nil))
+		require.NoError(t, err)
+		require.Equal(t, true, result == nil)
+
+		// The surrounded vote is surrounded by the attestation with target 3.
+		surroundedVote := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil)
+		result, err = chunk.CheckSlashableDetailed(ctx, slasherDB, validatorIdx, surroundedVote)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.Equal(t, false, reflect.ValueOf(result.Slashing).IsNil())
+		require.Equal(t, primitives.Epoch(0), result.MinTarget)
+		require.Equal(t, primitives.Epoch(3), result.MaxTarget)
+	})
+}
+
+func TestSpanChunksSlice_CheckSlashable_MemoryRecordReader(t *testing.T) {
+	ctx := context.Background()
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	validatorIdx := primitives.ValidatorIndex(1)
+
+	// Mark an attestation with (source 0, target 3) as attested in a max span chunk.
+	chunk := EmptyMaxSpanChunksSlice(params)
+	_, err := chunk.Update(0, 3, validatorIdx, 0, 3)
+	require.NoError(t, err)
+
+	surroundedVote := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil)
+
+	// Without the attestation record, the surrounded vote is not slashable.
+	recordReader := memoryAttestationRecordReader{}
+	slashing, err := chunk.CheckSlashable(ctx, recordReader, validatorIdx, surroundedVote)
+	require.NoError(t, err)
+	require.Equal(t, nil, slashing)
+
+	// With the attestation record in memory, the surrounded vote is slashable.
+	attRecord := createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, []uint64{uint64(validatorIdx)}, []byte{1})
+	recordReader[validatorIdx] = map[primitives.Epoch]*slashertypes.IndexedAttestationWrapper{3: attRecord}
+
+	slashing, err = chunk.CheckSlashable(ctx, recordReader, validatorIdx, surroundedVote)
+	require.NoError(t, err)
+	require.Equal(t, false, reflect.ValueOf(slashing).IsNil())
+}
+
+func TestChunksSlice_CheckSlashable_SourceNotBeforeTarget(t *testing.T) {
+	ctx := context.Background()
+	params := DefaultParams()
+	validatorIdx := primitives.ValidatorIndex(1)
+
+	// The chunks are empty, so any chunk access would fail with a different error.
+	chunks := []Chunker{
+		&MinSpanChunksSlice{params: params, data: []uint16{}},
+		&MaxSpanChunksSlice{params: params, data: []uint16{}},
+	}
+
+	for _, chunk := range chunks {
+		// Source after target.
+		att := createAttestationWrapperEmptySig(t, version.Phase0, 3, 2, nil, nil)
+		_, err := chunk.CheckSlashable(ctx, nil, validatorIdx, att)
+		require.ErrorContains(t, "source epoch 3 is not before target epoch 2", err)
+
+		// Source equal to target.
+		att = createAttestationWrapperEmptySig(t, version.Phase0, 2, 2, nil, nil)
+		_, err = chunk.CheckSlashable(ctx, nil, validatorIdx, att)
+		require.ErrorContains(t, "source epoch 2 is not before target epoch 2", err)
+
+		// The genesis attestation passes the validation, and fails on the chunk access.
+		att = createAttestationWrapperEmptySig(t, version.Phase0, 0, 0, nil, nil)
+		_, err = chunk.CheckSlashable(ctx, nil, validatorIdx, att)
+		require.ErrorContains(t, "could not get", err)
+	}
+}
+
 func TestMaxSpanChunksSlice_CheckSlashable(t *testing.T) {
 	ctx := context.Background()
 
@@ -458,6 +822,67 @@ func TestMaxSpanChunksSlice_Update_MultipleChunks(t *testing.T) {
 	require.DeepEqual(t, want, chunk.Chunk())
 }
 
+func TestSpanChunksSlice_UpdateDryRun(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+	validatorIdx := primitives.ValidatorIndex(0)
+
+	t.Run("min span", func(t *testing.T) {
+		chunk := EmptyMinSpanChunksSlice(params)
+		before := make([]uint16, len(chunk.Chunk()))
+		copy(before, chunk.Chunk())
+
+		// Epochs 3 and 2 belong to chunk index 1, so the update should continue with chunk index 0.
+		epochs, keepGoing, err := chunk.UpdateDryRun(1, 3, validatorIdx, 3, 4)
+		require.NoError(t, err)
+		require.DeepEqual(t, []primitives.Epoch{3, 2}, epochs)
+		require.Equal(t, true, keepGoing)
+		require.DeepEqual(t, before, chunk.Chunk())
+
+		// Once the update is applied, there is nothing left to change.
+		keepGoing, err = chunk.Update(1, 3, validatorIdx, 3, 4)
+		require.NoError(t, err)
+		require.Equal(t, true, keepGoing)
+
+		epochs, keepGoing, err = chunk.UpdateDryRun(1, 3, validatorIdx, 3, 4)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(epochs))
+		require.Equal(t, false, keepGoing)
+	})
+
+	t.Run("max span", func(t *testing.T) {
+		chunk := EmptyMaxSpanChunksSlice(params)
+		before := make([]uint16, len(chunk.Chunk()))
+		copy(before, chunk.Chunk())
+
+		// Epochs 0 and 1 belong to chunk index 0, so the update should continue with chunk index 1.
+		epochs, keepGoing, err := chunk.UpdateDryRun(0, 3, validatorIdx, 0, 3)
+		require.NoError(t, err)
+		require.DeepEqual(t, []primitives.Epoch{0, 1}, epochs)
+		require.Equal(t, true, keepGoing)
+		require.DeepEqual(t, before, chunk.Chunk())
+
+		// Once the update is applied, there is nothing left to change.
+		keepGoing, err = chunk.Update(0, 3, validatorIdx, 0, 3)
+		require.NoError(t, err)
+		require.Equal(t, true, keepGoing)
+
+		epochs, keepGoing, err = chunk.UpdateDryRun(0, 3, validatorIdx, 0, 3)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(epochs))
+		require.Equal(t, false, keepGoing)
+	})
+
+	t.Run("faulty chunk", func(t *testing.T) {
+		chunk := &MinSpanChunksSlice{params: params, data: []uint16{}}
+		_, _, err := chunk.UpdateDryRun(0, 1, validatorIdx, 1, 2)
+		require.ErrorContains(t, "could not get chunk data at epoch 1", err)
+	})
+}
+
 func TestMinSpanChunksSlice_Update_SingleChunk(t *testing.T) {
 	// Let's set H = historyLength = 2, meaning a min span
 	// will hold 2 epochs worth of attesting history. Then we set C = 2 meaning we will
@@ -754,3 +1179,341 @@ func Test_chunkDataAtEpoch_SetRetrieve(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, targetEpoch, received)
 }
+
+func TestSpanChunksSlice_DataAtEpoch_SetRetrieve(t *testing.T) {
+	// We initialize chunks slices for 2 validators and with chunk size 3,
+	// which will look as follows:
+	//
+	//     val0     val1
+	//   {     }  {     }
+	//  [2, 2, 2, 2, 2, 2]
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+	}
+	validatorIdx := primitives.ValidatorIndex(1)
+	epochInChunk := primitives.Epoch(1)
+	targetEpoch := primitives.Epoch(6)
+
+	minChunk, err := MinChunkSpansSliceFrom(params, []uint16{2, 2, 2, 2, 2, 2})
+	require.NoError(t, err)
+	maxChunk, err := MaxChunkSpansSliceFrom(params, []uint16{2, 2, 2, 2, 2, 2})
+	require.NoError(t, err)
+
+	for _, chunk := range []interface {
+		DataAtEpoch(primitives.ValidatorIndex, primitives.Epoch) (primitives.Epoch, error)
+		SetDataAtEpoch(primitives.ValidatorIndex, primitives.Epoch, primitives.Epoch) error
+	}{minChunk, maxChunk} {
+		// We expect the value at epoch 1 to be the distance 2 from epoch 1.
+		received, err := chunk.DataAtEpoch(validatorIdx, epochInChunk)
+		require.NoError(t, err)
+		assert.Equal(t, primitives.Epoch(3), received)
+
+		// We update the value for epoch 1 using target epoch 6.
+		require.NoError(t, chunk.SetDataAtEpoch(validatorIdx, epochInChunk, targetEpoch))
+
+		// We expect the retrieved value at epoch 1 is the target epoch 6.
+		received, err = chunk.DataAtEpoch(validatorIdx, epochInChunk)
+		require.NoError(t, err)
+		assert.Equal(t, targetEpoch, received)
+	}
+
+	// We expect a chunk with the wrong length to throw an error.
+	faultyChunk := &MinSpanChunksSlice{params: params, data: []uint16{}}
+	_, err = faultyChunk.DataAtEpoch(validatorIdx, epochInChunk)
+	require.ErrorContains(t, "chunk has wrong length", err)
+}
+
+func Test_fillChunk(t *testing.T) {
+	for _, length := range []int{0, 1, 2, 3, 7, 8, 9, 4095, 4096, 4097} {
+		for _, value := range []uint16{0, 2, math.MaxUint16} {
+			// Reference fill, assigning every cell individually.
+			want := make([]uint16, length)
+			for i := range want {
+				want[i] = value
+			}
+
+			got := make([]uint16, length)
+			fillChunk(got, value)
+			require.DeepEqual(t, want, got)
+		}
+	}
+}
+
+func TestEmptySpanChunksSlice_Neutral(t *testing.T) {
+	params := DefaultParams()
+	length := params.chunkSize * params.validatorChunkSize
+
+	minChunk := EmptyMinSpanChunksSlice(params)
+	require.Equal(t, length, uint64(len(minChunk.Chunk())))
+	for _, value := range minChunk.Chunk() {
+		require.Equal(t, minChunk.NeutralElement(), uint32(value))
+	}
+
+	maxChunk := EmptyMaxSpanChunksSlice(params)
+	require.Equal(t, length, uint64(len(maxChunk.Chunk())))
+	for _, value := range maxChunk.Chunk() {
+		require.Equal(t, maxChunk.NeutralElement(), uint32(value))
+	}
+}
+
+func TestFilledSpanChunksSlice(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      6,
+	}
+	wideParams, err := params.WithElementWidth(ElementWidth32)
+	require.NoError(t, err)
+
+	for _, p := range []*Parameters{params, wideParams} {
+		minChunk, err := FilledMinSpanChunksSlice(p, 4)
+		require.NoError(t, err)
+		require.Equal(t, p.chunkLength(), uint64(len(minChunk.Chunk())))
+
+		maxChunk, err := FilledMaxSpanChunksSlice(p, 4)
+		require.NoError(t, err)
+		require.Equal(t, p.chunkLength(), uint64(len(maxChunk.Chunk())))
+
+		for validatorIdx := primitives.ValidatorIndex(0); validatorIdx < 2; validatorIdx++ {
+			for epoch := primitives.Epoch(0); epoch < 3; epoch++ {
+				minTarget, err := minChunk.DataAtEpoch(validatorIdx, epoch)
+				require.NoError(t, err)
+				require.Equal(t, epoch+4, minTarget)
+
+				maxTarget, err := maxChunk.DataAtEpoch(validatorIdx, epoch)
+				require.NoError(t, err)
+				require.Equal(t, epoch+4, maxTarget)
+			}
+		}
+	}
+
+	// Boundaries.
+	_, err = FilledMinSpanChunksSlice(params, 0)
+	require.ErrorContains(t, "min span value 0 out of range [1, 6]", err)
+	_, err = FilledMinSpanChunksSlice(params, 6)
+	require.NoError(t, err)
+	_, err = FilledMinSpanChunksSlice(params, 7)
+	require.ErrorContains(t, "min span value 7 out of range [1, 6]", err)
+
+	_, err = FilledMaxSpanChunksSlice(params, 0)
+	require.NoError(t, err)
+	_, err = FilledMaxSpanChunksSlice(params, 6)
+	require.NoError(t, err)
+	_, err = FilledMaxSpanChunksSlice(params, 7)
+	require.ErrorContains(t, "max span value 7 out of range [0, 6]", err)
+}
+
+func TestMergeSpanChunks(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+
+	t.Run("min spans", func(t *testing.T) {
+		a, err := MinChunkSpansSliceFrom(params, []uint16{1, 4, math.MaxUint16, 2})
+		require.NoError(t, err)
+		b, err := MinChunkSpansSliceFrom(params, []uint16{3, 2, 3, math.MaxUint16})
+		require.NoError(t, err)
+
+		merged, err := MergeMinSpanChunks(a, b)
+		require.NoError(t, err)
+		require.DeepEqual(t, []uint16{1, 2, 3, 2}, merged.Chunk())
+
+		// The inputs are left unchanged.
+		require.DeepEqual(t, []uint16{1, 4, math.MaxUint16, 2}, a.Chunk())
+		require.DeepEqual(t, []uint16{3, 2, 3, math.MaxUint16}, b.Chunk())
+	})
+
+	t.Run("max spans", func(t *testing.T) {
+		a, err := MaxChunkSpansSliceFrom(params, []uint16{1, 4, 0, 2})
+		require.NoError(t, err)
+		b, err := MaxChunkSpansSliceFrom(params, []uint16{3, 2, 3, 0})
+		require.NoError(t, err)
+
+		merged, err := MergeMaxSpanChunks(a, b)
+		require.NoError(t, err)
+		require.DeepEqual(t, []uint16{3, 4, 3, 2}, merged.Chunk())
+	})
+
+	t.Run("32 bits elements", func(t *testing.T) {
+		wideParams, err := params.WithElementWidth(ElementWidth32)
+		require.NoError(t, err)
+
+		a := EmptyMinSpanChunksSlice(wideParams)
+		b := EmptyMinSpanChunksSlice(wideParams)
+		require.NoError(t, a.SetDataAtEpoch(1, 1, 1+70_000))
+		require.NoError(t, b.SetDataAtEpoch(1, 1, 1+65_536))
+		require.NoError(t, b.SetDataAtEpoch(0, 0, 3))
+
+		merged, err := MergeMinSpanChunks(a, b)
+		require.NoError(t, err)
+
+		target, err := merged.DataAtEpoch(1, 1)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Epoch(1+65_536), target)
+
+		target, err = merged.DataAtEpoch(0, 0)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Epoch(3), target)
+	})
+
+	t.Run("different parameters", func(t *testing.T) {
+		otherParams := &Parameters{
+			chunkSize:          2,
+			validatorChunkSize: 2,
+			historyLength:      8,
+		}
+
+		_, err := MergeMinSpanChunks(EmptyMinSpanChunksSlice(params), EmptyMinSpanChunksSlice(otherParams))
+		require.ErrorContains(t, "parameters differ", err)
+
+		_, err = MergeMaxSpanChunks(EmptyMaxSpanChunksSlice(params), EmptyMaxSpanChunksSlice(otherParams))
+		require.ErrorContains(t, "parameters differ", err)
+	})
+
+	t.Run("default and explicit element width", func(t *testing.T) {
+		explicitParams, err := params.WithElementWidth(ElementWidth16)
+		require.NoError(t, err)
+
+		_, err = MergeMaxSpanChunks(EmptyMaxSpanChunksSlice(params), EmptyMaxSpanChunksSlice(explicitParams))
+		require.NoError(t, err)
+	})
+}
+
+func BenchmarkEmptyMinSpanChunksSlice(b *testing.B) {
+	// A large chunk: 1024 epochs per chunk for 4096 validators.
+	params := &Parameters{
+		chunkSize:          1024,
+		validatorChunkSize: 4096,
+		historyLength:      4096,
+	}
+
+	b.Run("fill", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			EmptyMinSpanChunksSlice(params)
+		}
+	})
+
+	b.Run("per cell", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			data := make([]uint16, params.chunkSize*params.validatorChunkSize)
+			for j := 0; j < len(data); j++ {
+				data[j] = math.MaxUint16
+			}
+		}
+	})
+}
+
+func TestSpanChunksSlice_Update_SelfCheck(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableSlasherSelfCheck: true})
+	defer resetCfg()
+
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+
+	t.Run("valid updates", func(t *testing.T) {
+		minChunk := EmptyMinSpanChunksSlice(params)
+		_, err := minChunk.Update(0, 1, 0, 1, 2)
+		require.NoError(t, err)
+
+		maxChunk := EmptyMaxSpanChunksSlice(params)
+		_, err = maxChunk.Update(0, 1, 0, 0, 3)
+		require.NoError(t, err)
+	})
+
+	t.Run("inconsistent update", func(t *testing.T) {
+		// The distance between the epoch and the target epoch does not fit in a chunk cell,
+		// so the updated cell cannot be read back as the target epoch.
+		maxChunk := EmptyMaxSpanChunksSlice(params)
+		_, err := maxChunk.Update(0, 0, 0, 0, math.MaxUint16+1)
+		require.ErrorContains(t, "slasher self-check failed", err)
+	})
+}
+
+func TestSpanChunksSlice_ElementWidth32(t *testing.T) {
+	ctx := context.Background()
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+
+	_, err := params.WithElementWidth(24)
+	require.ErrorContains(t, "unsupported element width 24", err)
+
+	defaultParams, err := params.WithElementWidth(0)
+	require.NoError(t, err)
+	require.Equal(t, ElementWidth16, defaultParams.ElementWidth())
+
+	longParams := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      65536,
+	}
+	_, err = longParams.WithElementWidth(ElementWidth16)
+	require.ErrorContains(t, "history length 65536 is too long for 16 bits elements", err)
+	_, err = longParams.WithElementWidth(ElementWidth32)
+	require.NoError(t, err)
+
+	wideParams, err := params.WithElementWidth(ElementWidth32)
+	require.NoError(t, err)
+	require.Equal(t, ElementWidth16, params.ElementWidth())
+	require.Equal(t, ElementWidth32, wideParams.ElementWidth())
+
+	t.Run("lengths", func(t *testing.T) {
+		_, err := MinChunkSpansSliceFrom(wideParams, make([]uint16, 4))
+		require.ErrorContains(t, "chunk has wrong length, 4, expected 8", err)
+		_, err = MaxChunkSpansSliceFrom(wideParams, make([]uint16, 4))
+		require.ErrorContains(t, "chunk has wrong length, 4, expected 8", err)
+
+		_, err = MinChunkSpansSliceFrom(wideParams, make([]uint16, 8))
+		require.NoError(t, err)
+		_, err = MaxChunkSpansSliceFrom(wideParams, make([]uint16, 8))
+		require.NoError(t, err)
+	})
+
+	t.Run("neutral elements", func(t *testing.T) {
+		minChunk := EmptyMinSpanChunksSlice(wideParams)
+		require.Equal(t, 8, len(minChunk.Chunk()))
+		require.Equal(t, uint32(math.MaxUint32), minChunk.NeutralElement())
+
+		minTarget, err := chunkDataAtEpoch(wideParams, minChunk.Chunk(), 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Epoch(1+math.MaxUint32), minTarget)
+
+		maxChunk := EmptyMaxSpanChunksSlice(wideParams)
+		require.Equal(t, 8, len(maxChunk.Chunk()))
+		require.Equal(t, uint32(0), maxChunk.NeutralElement())
+	})
+
+	t.Run("distances wider than 16 bits", func(t *testing.T) {
+		slasherDB := dbtest.SetupSlasherDB(t)
+		validatorIdx := primitives.ValidatorIndex(1)
+		target := primitives.Epoch(math.MaxUint16 + 10)
+
+		// Mark an attestation with (source 0, target MaxUint16 + 10) as attested, and save its record.
+		maxChunk := EmptyMaxSpanChunksSlice(wideParams)
+		_, err := maxChunk.Update(0, 1, validatorIdx, 0, target)
+		require.NoError(t, err)
+
+		for _, epoch := range []primitives.Epoch{0, 1} {
+			maxTarget, err := chunkDataAtEpoch(wideParams, maxChunk.Chunk(), validatorIdx, epoch)
+			require.NoError(t, err)
+			require.Equal(t, target, maxTarget)
+		}
+
+		attRecord := createAttestationWrapperEmptySig(t, version.Phase0, 0, target, []uint64{uint64(validatorIdx)}, []byte{1})
+		require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{attRecord}))
+
+		// A vote surrounded by the recorded attestation should be slashable.
+		surroundedVote := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil)
+		slashing, err := maxChunk.CheckSlashable(ctx, slasherDB, validatorIdx, surroundedVote)
+		require.NoError(t, err)
+		require.Equal(t, false, reflect.ValueOf(slashing).IsNil())
+	})
+}
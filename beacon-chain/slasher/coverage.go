@@ -0,0 +1,140 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Scans the span chunks at the start of every epoch to report the history coverage.
+func (s *Service) scanHistoryCoverage(ctx context.Context, slotTicker <-chan primitives.Slot) {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			if !slots.IsEpochStart(currentSlot) {
+				continue
+			}
+
+			if _, err := s.updateHistoryCoverage(ctx, slots.ToEpoch(currentSlot)); err != nil {
+				log.WithError(err).Error("Could not update slasher history coverage")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Reports, via the `slasher_history_coverage_epochs` gauge, how many epochs back from the current
+// epoch (included) the spans contain non-neutral data, and returns this coverage. The min and max span
+// chunks of the validators known by the service are read from the database, from the oldest epoch of
+// the history window ending at the current epoch onwards, until a non-neutral cell is found.
+func (s *Service) updateHistoryCoverage(ctx context.Context, currentEpoch primitives.Epoch) (primitives.Epoch, error) {
+	// Determine the validator chunk indexes containing validators with spans.
+	s.detectionLock.Lock()
+	validatorChunkIndexes := make([]uint64, 0)
+	validatorChunkIndexesMap := make(map[uint64]bool)
+	for validatorIndex := range s.latestEpochUpdatedForValidator {
+		validatorChunkIndex := s.params.validatorChunkIndex(validatorIndex)
+		if !validatorChunkIndexesMap[validatorChunkIndex] {
+			validatorChunkIndexesMap[validatorChunkIndex] = true
+			validatorChunkIndexes = append(validatorChunkIndexes, validatorChunkIndex)
+		}
+	}
+	s.detectionLock.Unlock()
+
+	// The oldest epoch within the history window.
+	firstEpoch := primitives.Epoch(0)
+	if currentEpoch >= s.params.historyLength {
+		firstEpoch = currentEpoch + 1 - s.params.historyLength
+	}
+
+	// Chunks are loaded once for all the epochs they contain.
+	chunksByChunkIndex := make(map[uint64][]Chunker)
+
+	coverage := primitives.Epoch(0)
+	for epoch := firstEpoch; epoch <= currentEpoch && len(validatorChunkIndexes) > 0; epoch++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		chunkIndex := s.params.chunkIndex(epoch)
+		chunks, ok := chunksByChunkIndex[chunkIndex]
+		if !ok {
+			var err error
+			chunks, err = s.loadExistingChunks(ctx, validatorChunkIndexes, chunkIndex)
+			if err != nil {
+				return 0, err
+			}
+
+			chunksByChunkIndex[chunkIndex] = chunks
+		}
+
+		if s.hasNonNeutralCell(chunks, epoch) {
+			coverage = currentEpoch - epoch + 1
+			break
+		}
+	}
+
+	historyCoverageEpochs.Set(float64(coverage))
+	return coverage, nil
+}
+
+// Loads the min and max span chunks existing in the database for the given validator
+// chunk indexes and chunk index.
+func (s *Service) loadExistingChunks(ctx context.Context, validatorChunkIndexes []uint64, chunkIndex uint64) ([]Chunker, error) {
+	chunkKeys := make([][]byte, 0, len(validatorChunkIndexes))
+	for _, validatorChunkIndex := range validatorChunkIndexes {
+		chunkKeys = append(chunkKeys, s.params.flatSliceID(validatorChunkIndex, chunkIndex))
+	}
+
+	chunks := make([]Chunker, 0, 2*len(validatorChunkIndexes))
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		rawChunks, chunksExist, err := s.serviceCfg.Database.LoadSlasherChunks(ctx, kind, chunkKeys)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load %s chunks for chunk index %d", kind, chunkIndex)
+		}
+
+		for i, validatorChunkIndex := range validatorChunkIndexes {
+			if !chunksExist[i] {
+				continue
+			}
+
+			var chunk Chunker
+			switch kind {
+			case slashertypes.MinSpan:
+				chunk, err = MinChunkSpansSliceFrom(s.params, rawChunks[i])
+			case slashertypes.MaxSpan:
+				chunk, err = MaxChunkSpansSliceFrom(s.params, rawChunks[i])
+			}
+			if err != nil {
+				return nil, errors.Wrapf(
+					err, "could not initialize %s chunk for validator chunk index %d and chunk index %d",
+					kind, validatorChunkIndex, chunkIndex,
+				)
+			}
+
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	return chunks, nil
+}
+
+// Returns true if the cell of any validator at the given epoch is not neutral in any of the chunks.
+func (s *Service) hasNonNeutralCell(chunks []Chunker, epoch primitives.Epoch) bool {
+	for _, chunk := range chunks {
+		for validatorOffset := uint64(0); validatorOffset < s.params.validatorChunkSize; validatorOffset++ {
+			cellIndex := s.params.cellIndex(primitives.ValidatorIndex(validatorOffset), epoch)
+			if chunkElement(s.params, chunk.Chunk(), cellIndex) != chunk.NeutralElement() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_updateHistoryCoverage(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+
+	// 2 validators per chunk, 2 epochs per chunk, 8 epochs worth of history.
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      8,
+	}
+
+	// The history window ending at the current epoch 10 starts at epoch 3.
+	const currentEpoch = primitives.Epoch(10)
+
+	s := &Service{
+		params: params,
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+		latestEpochUpdatedForValidator: map[primitives.ValidatorIndex]primitives.Epoch{
+			0: currentEpoch,
+			3: currentEpoch,
+		},
+	}
+
+	// Saves span chunks containing non-neutral data for a validator within the given epochs.
+	saveSpans := func(kind slashertypes.ChunkKind, validatorIndex primitives.ValidatorIndex, fromEpoch, toEpoch primitives.Epoch) {
+		chunkByChunkIndex, err := s.loadChunksFromDisk(ctx, params.validatorChunkIndex(validatorIndex), kind, []uint64{0, 1, 2, 3})
+		require.NoError(t, err)
+
+		for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+			chunk := chunkByChunkIndex[params.chunkIndex(epoch)]
+			switch c := chunk.(type) {
+			case *MinSpanChunksSlice:
+				require.NoError(t, c.SetDataAtEpoch(validatorIndex, epoch, epoch+1))
+			case *MaxSpanChunksSlice:
+				require.NoError(t, c.SetDataAtEpoch(validatorIndex, epoch, epoch+1))
+			}
+		}
+
+		require.NoError(t, s.saveChunksToDisk(ctx, kind, map[uint64]map[uint64]Chunker{
+			params.validatorChunkIndex(validatorIndex): chunkByChunkIndex,
+		}))
+	}
+
+	// Without any span in the database, there is no coverage.
+	coverage, err := s.updateHistoryCoverage(ctx, currentEpoch)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Epoch(0), coverage)
+	require.Equal(t, float64(0), testutil.ToFloat64(historyCoverageEpochs))
+
+	// The min spans of validator 3 contain data from epoch 6 to the current epoch.
+	saveSpans(slashertypes.MinSpan, 3, 6, currentEpoch)
+	coverage, err = s.updateHistoryCoverage(ctx, currentEpoch)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Epoch(5), coverage)
+	require.Equal(t, float64(5), testutil.ToFloat64(historyCoverageEpochs))
+
+	// The max spans of validator 0 contain data from epoch 4 to epoch 5.
+	saveSpans(slashertypes.MaxSpan, 0, 4, 5)
+	coverage, err = s.updateHistoryCoverage(ctx, currentEpoch)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Epoch(7), coverage)
+	require.Equal(t, float64(7), testutil.ToFloat64(historyCoverageEpochs))
+
+	// The coverage is capped to the history length.
+	coverage, err = s.updateHistoryCoverage(ctx, 12)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Epoch(8), coverage)
+	require.Equal(t, float64(8), testutil.ToFloat64(historyCoverageEpochs))
+}
+
+func TestService_scanHistoryCoverage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+		latestEpochUpdatedForValidator: map[primitives.ValidatorIndex]primitives.Epoch{0: 1},
+	}
+
+	historyCoverageEpochs.Set(3)
+	slotTicker := make(chan primitives.Slot)
+
+	s.wg.Add(1)
+	go s.scanHistoryCoverage(ctx, slotTicker)
+
+	// Slots which do not start an epoch do not trigger a scan.
+	slotTicker <- 1
+	slotTicker <- 2
+	require.Equal(t, float64(3), testutil.ToFloat64(historyCoverageEpochs))
+
+	// The first slot of an epoch triggers a scan, which finds no span in the database.
+	// The next slot is only received once the scan is done.
+	slotTicker <- params.BeaconConfig().SlotsPerEpoch
+	slotTicker <- params.BeaconConfig().SlotsPerEpoch + 1
+	cancel()
+	s.wg.Wait()
+	require.Equal(t, float64(0), testutil.ToFloat64(historyCoverageEpochs))
+}
@@ -1,7 +1,6 @@
 package slasher
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"maps"
@@ -11,9 +10,10 @@ import (
 	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
-	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/sirupsen/logrus"
 )
 
 // Takes in a list of indexed attestation wrappers and returns any
@@ -50,7 +50,103 @@ func (s *Service) checkSlashableAttestations(
 		slashings[root] = slashing
 	}
 
-	return slashings, nil
+	return s.filterSlashingsBelowThreshold(slashings), nil
+}
+
+// Removes the attester slashings slashing fewer validators than the configured threshold.
+func (s *Service) filterSlashingsBelowThreshold(
+	slashings map[[fieldparams.RootLength]byte]ethpb.AttSlashing,
+) map[[fieldparams.RootLength]byte]ethpb.AttSlashing {
+	threshold := s.serviceCfg.MinSlashedValidators
+	if threshold == 0 {
+		return slashings
+	}
+
+	for root, slashing := range slashings {
+		slashedIndices := slice.IntersectionUint64(
+			slashing.FirstAttestation().GetAttestingIndices(),
+			slashing.SecondAttestation().GetAttestingIndices(),
+		)
+
+		if uint64(len(slashedIndices)) >= threshold {
+			continue
+		}
+
+		log.WithFields(logrus.Fields{
+			"slashedValidatorsCount": len(slashedIndices),
+			"threshold":              threshold,
+			"targetEpoch":            slashing.SecondAttestation().GetData().Target.Epoch,
+		}).Debug("Suppressing attester slashing below the slashed validators threshold")
+
+		suppressedAttesterSlashingsTotal.Inc()
+		delete(slashings, root)
+	}
+
+	return slashings
+}
+
+// Reports an attestation which is not slashable against the min or max span chunk, but would
+// be if its target epoch was off by at most `NearSlashableMargin` epochs. Such an attestation
+// only increments a counter and logs a warning, no slashing is produced. It returns true if
+// the attestation is near slashable.
+func (s *Service) checkNearSlashable(
+	chunk Chunker,
+	chunkKind slashertypes.ChunkKind,
+	validatorIndex primitives.ValidatorIndex,
+	attestation *slashertypes.IndexedAttestationWrapper,
+) bool {
+	margin := s.serviceCfg.NearSlashableMargin
+	if margin == 0 {
+		return false
+	}
+
+	sourceEpoch := attestation.IndexedAttestation.GetData().Source.Epoch
+	targetEpoch := attestation.IndexedAttestation.GetData().Target.Epoch
+
+	spanTarget, err := chunkDataAtEpoch(s.params, chunk.Chunk(), validatorIndex, sourceEpoch)
+	if err != nil {
+		return false
+	}
+
+	// A neutral element means there is no existing attestation to compare with.
+	if uint64(spanTarget.Sub(uint64(sourceEpoch))) == uint64(chunk.NeutralElement()) {
+		return false
+	}
+
+	// Number of epochs the target epoch would have to move for the attestation to be slashable.
+	var epochsToSlashable primitives.Epoch
+	switch chunkKind {
+	case slashertypes.MinSpan:
+		// Surrounding if and only if target > min target.
+		if targetEpoch > spanTarget {
+			return false
+		}
+		epochsToSlashable = spanTarget - targetEpoch + 1
+	case slashertypes.MaxSpan:
+		// Surrounded if and only if target < max target.
+		if targetEpoch < spanTarget {
+			return false
+		}
+		epochsToSlashable = targetEpoch - spanTarget + 1
+	default:
+		return false
+	}
+
+	if uint64(epochsToSlashable) > margin {
+		return false
+	}
+
+	log.WithFields(logrus.Fields{
+		"validatorIndex":    validatorIndex,
+		"sourceEpoch":       sourceEpoch,
+		"targetEpoch":       targetEpoch,
+		"spanTargetEpoch":   spanTarget,
+		"epochsToSlashable": epochsToSlashable,
+		"kind":              chunkKind,
+	}).Warn("Attestation is within the configured margin of being slashable")
+
+	nearSlashableAttestationsTotal.Inc()
+	return true
 }
 
 // Check for surrounding and surrounded votes in our database given a list of incoming attestations.
@@ -195,70 +291,9 @@ func (s *Service) checkDoubleVotes(
 			// This is a double vote.
 			doubleVotesTotal.Inc()
 
-			var slashing ethpb.AttSlashing
-
-			// Both attestations should have the same type. If not, we convert both to Electra attestations.
-			unifyAttWrapperVersion(existingAttWrapper, incomingAttWrapper)
-
-			postElectra := existingAttWrapper.IndexedAttestation.Version() >= version.Electra
-			if postElectra {
-				existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
-				if !ok {
-					return nil, fmt.Errorf(
-						"existing attestation has wrong type (expected %T, got %T)",
-						&ethpb.IndexedAttestationElectra{},
-						existingAttWrapper.IndexedAttestation,
-					)
-				}
-				incoming, ok := incomingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
-				if !ok {
-					return nil, fmt.Errorf(
-						"incoming attestation has wrong type (expected %T, got %T)",
-						&ethpb.IndexedAttestationElectra{},
-						incomingAttWrapper.IndexedAttestation,
-					)
-				}
-				slashing = &ethpb.AttesterSlashingElectra{
-					Attestation_1: existing,
-					Attestation_2: incoming,
-				}
-
-				// Ensure the attestation with the lower data root is the first attestation.
-				if bytes.Compare(existingAttWrapper.DataRoot[:], incomingAttWrapper.DataRoot[:]) > 0 {
-					slashing = &ethpb.AttesterSlashingElectra{
-						Attestation_1: incoming,
-						Attestation_2: existing,
-					}
-				}
-			} else {
-				existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
-				if !ok {
-					return nil, fmt.Errorf(
-						"existing attestation has wrong type (expected %T, got %T)",
-						&ethpb.IndexedAttestation{},
-						existingAttWrapper.IndexedAttestation,
-					)
-				}
-				incoming, ok := incomingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
-				if !ok {
-					return nil, fmt.Errorf(
-						"incoming attestation has wrong type (expected %T, got %T)",
-						&ethpb.IndexedAttestation{},
-						incomingAttWrapper.IndexedAttestation,
-					)
-				}
-				slashing = &ethpb.AttesterSlashing{
-					Attestation_1: existing,
-					Attestation_2: incoming,
-				}
-
-				// Ensure the attestation with the lower data root is the first attestation.
-				if bytes.Compare(existingAttWrapper.DataRoot[:], incomingAttWrapper.DataRoot[:]) > 0 {
-					slashing = &ethpb.AttesterSlashing{
-						Attestation_1: incoming,
-						Attestation_2: existing,
-					}
-				}
+			slashing, err := newAttesterSlashing(existingAttWrapper, incomingAttWrapper)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not create attester slashing")
 			}
 
 			root, err := slashing.HashTreeRoot()
@@ -280,73 +315,9 @@ func (s *Service) checkDoubleVotes(
 	for _, doubleVote := range doubleVotes {
 		doubleVotesTotal.Inc()
 
-		wrapper_1 := doubleVote.Wrapper_1
-		wrapper_2 := doubleVote.Wrapper_2
-
-		var slashing ethpb.AttSlashing
-
-		// Both attestations should have the same type. If not, we convert both to Electra attestations.
-		unifyAttWrapperVersion(wrapper_1, wrapper_2)
-
-		postElectra := wrapper_1.IndexedAttestation.Version() >= version.Electra
-		if postElectra {
-			att_1, ok := wrapper_1.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
-			if !ok {
-				return nil, fmt.Errorf(
-					"first attestation has wrong type (expected %T, got %T)",
-					&ethpb.IndexedAttestationElectra{},
-					wrapper_1.IndexedAttestation,
-				)
-			}
-			att_2, ok := wrapper_2.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
-			if !ok {
-				return nil, fmt.Errorf(
-					"second attestation has wrong type (expected %T, got %T)",
-					&ethpb.IndexedAttestationElectra{},
-					wrapper_2.IndexedAttestation,
-				)
-			}
-			slashing = &ethpb.AttesterSlashingElectra{
-				Attestation_1: att_1,
-				Attestation_2: att_2,
-			}
-
-			// Ensure the attestation with the lower data root is the first attestation.
-			if bytes.Compare(wrapper_1.DataRoot[:], wrapper_2.DataRoot[:]) > 0 {
-				slashing = &ethpb.AttesterSlashingElectra{
-					Attestation_1: att_2,
-					Attestation_2: att_1,
-				}
-			}
-		} else {
-			att_1, ok := wrapper_1.IndexedAttestation.(*ethpb.IndexedAttestation)
-			if !ok {
-				return nil, fmt.Errorf(
-					"first attestation has wrong type (expected %T, got %T)",
-					&ethpb.IndexedAttestation{},
-					wrapper_1.IndexedAttestation,
-				)
-			}
-			att_2, ok := wrapper_2.IndexedAttestation.(*ethpb.IndexedAttestation)
-			if !ok {
-				return nil, fmt.Errorf(
-					"second attestation has wrong type (expected %T, got %T)",
-					&ethpb.IndexedAttestation{},
-					wrapper_2.IndexedAttestation,
-				)
-			}
-			slashing = &ethpb.AttesterSlashing{
-				Attestation_1: att_1,
-				Attestation_2: att_2,
-			}
-
-			// Ensure the attestation with the lower data root is the first attestation.
-			if bytes.Compare(wrapper_1.DataRoot[:], wrapper_2.DataRoot[:]) > 0 {
-				slashing = &ethpb.AttesterSlashing{
-					Attestation_1: att_2,
-					Attestation_2: att_1,
-				}
-			}
+		slashing, err := newAttesterSlashing(doubleVote.Wrapper_1, doubleVote.Wrapper_2)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create attester slashing")
 		}
 
 		root, err := slashing.HashTreeRoot()
@@ -606,6 +577,16 @@ func (s *Service) applyAttestationForValidator(
 
 	var err error
 
+	// A chunk only stores the spans of the validators of its validator chunk index, and the position
+	// of a validator within a chunk is its index modulo the validator chunk size. A validator of another
+	// validator chunk index would thus silently read and update the spans of a different validator.
+	if actual := s.params.validatorChunkIndex(validatorIndex); actual != validatorChunkIndex {
+		return nil, errors.Errorf(
+			"validator index %d belongs to validator chunk index %d, not %d",
+			validatorIndex, actual, validatorChunkIndex,
+		)
+	}
+
 	sourceEpoch := attestation.IndexedAttestation.GetData().Source.Epoch
 	targetEpoch := attestation.IndexedAttestation.GetData().Target.Epoch
 
@@ -638,6 +619,8 @@ func (s *Service) applyAttestationForValidator(
 		return slashing, nil
 	}
 
+	s.checkNearSlashable(chunk, chunkKind, validatorIndex, attestation)
+
 	// Get the first start epoch for the chunk. If it does not exist or
 	// is not possible based on the input arguments, do not continue with the update.
 	startEpoch, exists := chunk.StartEpoch(sourceEpoch, currentEpoch)
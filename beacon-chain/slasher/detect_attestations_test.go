@@ -786,6 +786,103 @@ func Test_processAttestations(t *testing.T) {
 	}
 }
 
+func Test_filterSlashingsBelowThreshold(t *testing.T) {
+	newSlashing := func(indices1, indices2 []uint64) ethpb.AttSlashing {
+		att1 := createAttestationWrapperEmptySig(t, version.Phase0, 0, 2, indices1, []byte{1})
+		att2 := createAttestationWrapperEmptySig(t, version.Phase0, 0, 2, indices2, []byte{2})
+		return &ethpb.AttesterSlashing{
+			Attestation_1: att1.IndexedAttestation.(*ethpb.IndexedAttestation),
+			Attestation_2: att2.IndexedAttestation.(*ethpb.IndexedAttestation),
+		}
+	}
+
+	// The small slashing slashes only validator 2, the large one slashes validators 1, 2 and 3.
+	smallRoot, largeRoot := [fieldparams.RootLength]byte{1}, [fieldparams.RootLength]byte{2}
+	smallSlashing := newSlashing([]uint64{1, 2}, []uint64{2, 3})
+	largeSlashing := newSlashing([]uint64{1, 2, 3}, []uint64{1, 2, 3})
+
+	for _, tt := range []struct {
+		name      string
+		threshold uint64
+		expected  [][fieldparams.RootLength]byte
+	}{
+		{name: "no threshold", threshold: 0, expected: [][fieldparams.RootLength]byte{smallRoot, largeRoot}},
+		{name: "threshold met by both", threshold: 1, expected: [][fieldparams.RootLength]byte{smallRoot, largeRoot}},
+		{name: "threshold met by the large slashing", threshold: 2, expected: [][fieldparams.RootLength]byte{largeRoot}},
+		{name: "threshold met exactly", threshold: 3, expected: [][fieldparams.RootLength]byte{largeRoot}},
+		{name: "threshold met by none", threshold: 4, expected: [][fieldparams.RootLength]byte{}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{serviceCfg: &ServiceConfig{MinSlashedValidators: tt.threshold}}
+
+			slashings := s.filterSlashingsBelowThreshold(map[[fieldparams.RootLength]byte]ethpb.AttSlashing{
+				smallRoot: smallSlashing,
+				largeRoot: largeSlashing,
+			})
+
+			require.Equal(t, len(tt.expected), len(slashings))
+			for _, root := range tt.expected {
+				_, ok := slashings[root]
+				require.Equal(t, true, ok)
+			}
+		})
+	}
+}
+
+func Test_checkNearSlashable(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	validatorIdx := primitives.ValidatorIndex(1)
+
+	// For every validator and epoch, the span target is 2 epochs after the epoch.
+	data := []uint16{2, 2, 2, 2, 2, 2}
+	minChunk, err := MinChunkSpansSliceFrom(params, data)
+	require.NoError(t, err)
+	maxChunk, err := MaxChunkSpansSliceFrom(params, data)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name     string
+		chunk    Chunker
+		kind     slashertypes.ChunkKind
+		margin   uint64
+		source   primitives.Epoch
+		target   primitives.Epoch
+		expected bool
+	}{
+		// The min target at epoch 1 is 3, an attestation with source 1 is surrounding from target 4.
+		{name: "min span disabled", chunk: minChunk, kind: slashertypes.MinSpan, margin: 0, source: 1, target: 3, expected: false},
+		{name: "min span just inside margin", chunk: minChunk, kind: slashertypes.MinSpan, margin: 1, source: 1, target: 3, expected: true},
+		{name: "min span outside margin", chunk: minChunk, kind: slashertypes.MinSpan, margin: 1, source: 1, target: 2, expected: false},
+		{name: "min span wider margin", chunk: minChunk, kind: slashertypes.MinSpan, margin: 2, source: 1, target: 2, expected: true},
+		{name: "min span neutral", chunk: EmptyMinSpanChunksSlice(params), kind: slashertypes.MinSpan, margin: 10, source: 1, target: 2, expected: false},
+		// The max target at epoch 1 is 3, an attestation with source 1 is surrounded up to target 2.
+		{name: "max span just inside margin", chunk: maxChunk, kind: slashertypes.MaxSpan, margin: 1, source: 1, target: 3, expected: true},
+		{name: "max span outside margin", chunk: maxChunk, kind: slashertypes.MaxSpan, margin: 1, source: 1, target: 4, expected: false},
+		{name: "max span neutral", chunk: EmptyMaxSpanChunksSlice(params), kind: slashertypes.MaxSpan, margin: 10, source: 1, target: 2, expected: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			defer hook.Reset()
+
+			s := &Service{params: params, serviceCfg: &ServiceConfig{NearSlashableMargin: tt.margin}}
+			att := createAttestationWrapperEmptySig(t, version.Phase0, tt.source, tt.target, nil, nil)
+
+			require.Equal(t, tt.expected, s.checkNearSlashable(tt.chunk, tt.kind, validatorIdx, att))
+
+			if tt.expected {
+				require.LogsContain(t, hook, "Attestation is within the configured margin of being slashable")
+				return
+			}
+
+			require.LogsDoNotContain(t, hook, "Attestation is within the configured margin of being slashable")
+		})
+	}
+}
+
 func Test_processQueuedAttestations_MultipleChunkIndices(t *testing.T) {
 	hook := logTest.NewGlobal()
 	defer hook.Reset()
@@ -1328,6 +1425,40 @@ func Test_applyAttestationForValidator_MaxSpanChunk(t *testing.T) {
 	require.NotNil(t, slashing)
 }
 
+func Test_applyAttestationForValidator_ValidatorOutOfChunk(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	srv, err := New(context.Background(),
+		&ServiceConfig{
+			Database:      slasherDB,
+			StateNotifier: &mock.MockStateNotifier{},
+			ClockWaiter:   startup.NewClockSynchronizer(),
+		})
+	require.NoError(t, err)
+
+	// The first validator of the validator chunk index 1 does not belong to the validator chunk index 0.
+	validatorChunkIndex := uint64(0)
+	validatorIdx := primitives.ValidatorIndex(srv.params.validatorChunkSize)
+	att := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil)
+
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		chunksByChunkIdx := map[uint64]Chunker{}
+		_, err = srv.applyAttestationForValidator(
+			ctx,
+			chunksByChunkIdx,
+			att,
+			kind,
+			validatorChunkIndex,
+			validatorIdx,
+			primitives.Epoch(3),
+		)
+		require.ErrorContains(t, "belongs to validator chunk index 1, not 0", err)
+
+		// No chunk is loaded nor updated.
+		require.Equal(t, 0, len(chunksByChunkIdx))
+	}
+}
+
 func Test_loadChunks_MinSpans(t *testing.T) {
 	testLoadChunks(t, slashertypes.MinSpan)
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
 	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
@@ -68,7 +69,7 @@ func (s *Service) filterAttestations(
 	validInFuture = make([]*slashertypes.IndexedAttestationWrapper, 0, len(attWrappers))
 
 	for _, attWrapper := range attWrappers {
-		if attWrapper == nil || !validateAttestationIntegrity(attWrapper.IndexedAttestation) {
+		if attWrapper == nil || validateAttestationIntegrity(attWrapper.IndexedAttestation) != nil {
 			numDropped++
 			continue
 		}
@@ -96,10 +97,10 @@ func (s *Service) filterAttestations(
 // source and target epochs, and that the source epoch of the attestation must
 // be less than the target epoch, which is a precondition for performing slashing
 // detection (except for the genesis epoch).
-func validateAttestationIntegrity(att ethpb.IndexedAtt) bool {
+func validateAttestationIntegrity(att ethpb.IndexedAtt) error {
 	// If an attestation is malformed, we drop it.
 	if att == nil || att.IsNil() || att.GetData().Source == nil || att.GetData().Target == nil {
-		return false
+		return errors.New("nil attestation data, source or target")
 	}
 
 	sourceEpoch := att.GetData().Source.Epoch
@@ -108,11 +109,15 @@ func validateAttestationIntegrity(att ethpb.IndexedAtt) bool {
 	// The genesis epoch is a special case, since all attestations formed in it
 	// will have source and target 0, and they should be considered valid.
 	if sourceEpoch == 0 && targetEpoch == 0 {
-		return true
+		return nil
 	}
 
 	// All valid attestations must have source epoch < target epoch.
-	return sourceEpoch < targetEpoch
+	if sourceEpoch >= targetEpoch {
+		return errors.Errorf("source epoch %d is not before target epoch %d", sourceEpoch, targetEpoch)
+	}
+
+	return nil
 }
 
 // Validates the signed beacon block header integrity, ensuring we have no nil values.
@@ -271,3 +276,62 @@ func unifyAttWrapperVersion(w1 *slashertypes.IndexedAttestationWrapper, w2 *slas
 		Signature:        w2.IndexedAttestation.GetSignature(),
 	}
 }
+
+// newAttesterSlashing builds the attester slashing made of two conflicting attestations.
+// Both attestations are converted to Electra attestations if their versions differ, and the
+// attestation with the lower data root is the first attestation of the slashing.
+func newAttesterSlashing(w1, w2 *slashertypes.IndexedAttestationWrapper) (ethpb.AttSlashing, error) {
+	// Both attestations should have the same type. If not, we convert both to Electra attestations.
+	unifyAttWrapperVersion(w1, w2)
+
+	// Ensure the attestation with the lower data root is the first attestation.
+	if bytes.Compare(w1.DataRoot[:], w2.DataRoot[:]) > 0 {
+		w1, w2 = w2, w1
+	}
+
+	if w1.IndexedAttestation.Version() >= version.Electra {
+		att1, ok := w1.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
+		if !ok {
+			return nil, fmt.Errorf(
+				"first attestation has wrong type (expected %T, got %T)",
+				&ethpb.IndexedAttestationElectra{},
+				w1.IndexedAttestation,
+			)
+		}
+		att2, ok := w2.IndexedAttestation.(*ethpb.IndexedAttestationElectra)
+		if !ok {
+			return nil, fmt.Errorf(
+				"second attestation has wrong type (expected %T, got %T)",
+				&ethpb.IndexedAttestationElectra{},
+				w2.IndexedAttestation,
+			)
+		}
+
+		return &ethpb.AttesterSlashingElectra{
+			Attestation_1: att1,
+			Attestation_2: att2,
+		}, nil
+	}
+
+	att1, ok := w1.IndexedAttestation.(*ethpb.IndexedAttestation)
+	if !ok {
+		return nil, fmt.Errorf(
+			"first attestation has wrong type (expected %T, got %T)",
+			&ethpb.IndexedAttestation{},
+			w1.IndexedAttestation,
+		)
+	}
+	att2, ok := w2.IndexedAttestation.(*ethpb.IndexedAttestation)
+	if !ok {
+		return nil, fmt.Errorf(
+			"second attestation has wrong type (expected %T, got %T)",
+			&ethpb.IndexedAttestation{},
+			w2.IndexedAttestation,
+		)
+	}
+
+	return &ethpb.AttesterSlashing{
+		Attestation_1: att1,
+		Attestation_2: att2,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package slasher
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 
@@ -302,47 +303,47 @@ func Test_logSlashingEvent(t *testing.T) {
 
 func Test_validateAttestationIntegrity(t *testing.T) {
 	tests := []struct {
-		name string
-		att  *ethpb.IndexedAttestation
-		want bool
+		name    string
+		att     *ethpb.IndexedAttestation
+		wantErr string
 	}{
 		{
-			name: "Nil attestation returns false",
-			att:  nil,
-			want: false,
+			name:    "Nil attestation returns error",
+			att:     nil,
+			wantErr: "nil attestation data, source or target",
 		},
 		{
-			name: "Nil attestation data returns false",
-			att:  &ethpb.IndexedAttestation{},
-			want: false,
+			name:    "Nil attestation data returns error",
+			att:     &ethpb.IndexedAttestation{},
+			wantErr: "nil attestation data, source or target",
 		},
 		{
-			name: "Nil attestation source and target returns false",
+			name: "Nil attestation source and target returns error",
 			att: &ethpb.IndexedAttestation{
 				Data: &ethpb.AttestationData{},
 			},
-			want: false,
+			wantErr: "nil attestation data, source or target",
 		},
 		{
-			name: "Nil attestation source and good target returns false",
+			name: "Nil attestation source and good target returns error",
 			att: &ethpb.IndexedAttestation{
 				Data: &ethpb.AttestationData{
 					Target: &ethpb.Checkpoint{},
 				},
 			},
-			want: false,
+			wantErr: "nil attestation data, source or target",
 		},
 		{
-			name: "Nil attestation target and good source returns false",
+			name: "Nil attestation target and good source returns error",
 			att: &ethpb.IndexedAttestation{
 				Data: &ethpb.AttestationData{
 					Source: &ethpb.Checkpoint{},
 				},
 			},
-			want: false,
+			wantErr: "nil attestation data, source or target",
 		},
 		{
-			name: "Source > target returns false",
+			name: "Source > target returns error",
 			att: &ethpb.IndexedAttestation{
 				Data: &ethpb.AttestationData{
 					Source: &ethpb.Checkpoint{
@@ -353,10 +354,10 @@ func Test_validateAttestationIntegrity(t *testing.T) {
 					},
 				},
 			},
-			want: false,
+			wantErr: "source epoch 1 is not before target epoch 0",
 		},
 		{
-			name: "Source == target returns false",
+			name: "Source == target returns error",
 			att: &ethpb.IndexedAttestation{
 				Data: &ethpb.AttestationData{
 					Source: &ethpb.Checkpoint{
@@ -367,10 +368,10 @@ func Test_validateAttestationIntegrity(t *testing.T) {
 					},
 				},
 			},
-			want: false,
+			wantErr: "source epoch 1 is not before target epoch 1",
 		},
 		{
-			name: "Source < target returns true",
+			name: "Source < target returns nil",
 			att: &ethpb.IndexedAttestation{
 				Data: &ethpb.AttestationData{
 					Source: &ethpb.Checkpoint{
@@ -381,10 +382,9 @@ func Test_validateAttestationIntegrity(t *testing.T) {
 					},
 				},
 			},
-			want: true,
 		},
 		{
-			name: "Source 0 target 0 returns true (genesis epoch attestations)",
+			name: "Source 0 target 0 returns nil (genesis epoch attestations)",
 			att: &ethpb.IndexedAttestation{
 				Data: &ethpb.AttestationData{
 					Source: &ethpb.Checkpoint{
@@ -395,14 +395,17 @@ func Test_validateAttestationIntegrity(t *testing.T) {
 					},
 				},
 			},
-			want: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := validateAttestationIntegrity(tt.att); got != tt.want {
-				t.Errorf("validateAttestationIntegrity() = %v, want %v", got, tt.want)
+			err := validateAttestationIntegrity(tt.att)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
 			}
+
+			require.NoError(t, err)
 		})
 	}
 }
@@ -516,3 +519,31 @@ func Test_isDoubleProposal(t *testing.T) {
 		})
 	}
 }
+
+func Test_newAttesterSlashing(t *testing.T) {
+	t.Run("same versions", func(t *testing.T) {
+		w1 := createAttestationWrapperEmptySig(t, version.Phase0, 0, 2, []uint64{1}, []byte{2})
+		w2 := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{1}, []byte{1})
+
+		slashing, err := newAttesterSlashing(w1, w2)
+		require.NoError(t, err)
+		require.Equal(t, version.Phase0, slashing.Version())
+
+		// The attestation with the lower data root is the first attestation.
+		first, second := w1, w2
+		if bytes.Compare(w1.DataRoot[:], w2.DataRoot[:]) > 0 {
+			first, second = w2, w1
+		}
+		require.DeepEqual(t, first.IndexedAttestation.GetData(), slashing.FirstAttestation().GetData())
+		require.DeepEqual(t, second.IndexedAttestation.GetData(), slashing.SecondAttestation().GetData())
+	})
+
+	t.Run("different versions", func(t *testing.T) {
+		w1 := createAttestationWrapperEmptySig(t, version.Phase0, 0, 2, []uint64{1}, []byte{1})
+		w2 := createAttestationWrapperEmptySig(t, version.Electra, 1, 2, []uint64{1}, []byte{2})
+
+		slashing, err := newAttesterSlashing(w1, w2)
+		require.NoError(t, err)
+		require.Equal(t, version.Electra, slashing.Version())
+	})
+}
@@ -3,6 +3,7 @@ package slasher
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 )
 
 var (
@@ -25,6 +26,10 @@ var (
 		Name: "slasher_attestations_dropped_total",
 		Help: "Total number of attestations dropped by slasher due to invalidity",
 	})
+	droppedDeferredAttestationsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_deferred_attestations_dropped_total",
+		Help: "Total number of attestations deferred to a future epoch and then dropped by the slasher stream",
+	})
 	processedAttestationsTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "slasher_attestations_processed_total",
 		Help: "Total number of attestations successfully processed by slasher",
@@ -53,4 +58,39 @@ var (
 		Name: "slasher_surrounded_votes_total",
 		Help: "Total slashable surrounded votes successfully detected by slasher",
 	})
+	chunkUpdateSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "slasher_chunk_update_seconds",
+			Help:    "Time spent updating a min or max span chunk for a validator, in seconds",
+			Buckets: []float64{0.00001, 0.0001, 0.001, 0.01, 0.1, 1},
+		},
+		[]string{"kind"},
+	)
+	checkSlashableSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "slasher_check_slashable_seconds",
+			Help:    "Time spent checking if an attestation is slashable against a min or max span chunk, in seconds",
+			Buckets: []float64{0.00001, 0.0001, 0.001, 0.01, 0.1, 1},
+		},
+		[]string{"kind"},
+	)
+	suppressedAttesterSlashingsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attester_slashings_suppressed_total",
+		Help: "Total attester slashings detected by slasher but suppressed for slashing too few validators",
+	})
+	nearSlashableAttestationsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_near_slashable_attestations_total",
+		Help: "Total attestations detected by slasher as not slashable but within the configured epoch margin of being slashable",
+	})
+	historyCoverageEpochs = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_history_coverage_epochs",
+		Help: "Number of epochs back from the current epoch for which slasher spans contain non-neutral data",
+	})
+
+	// Observers of the chunk timing histograms, resolved once per span kind
+	// to avoid a label lookup on every chunk update or slashing check.
+	minSpanChunkUpdateSeconds    = chunkUpdateSeconds.WithLabelValues(slashertypes.MinSpan.String())
+	maxSpanChunkUpdateSeconds    = chunkUpdateSeconds.WithLabelValues(slashertypes.MaxSpan.String())
+	minSpanCheckSlashableSeconds = checkSlashableSeconds.WithLabelValues(slashertypes.MinSpan.String())
+	maxSpanCheckSlashableSeconds = checkSlashableSeconds.WithLabelValues(slashertypes.MaxSpan.String())
 )
@@ -1,6 +1,10 @@
 package slasher
 
 import (
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 )
@@ -14,8 +18,20 @@ type Parameters struct {
 	chunkSize          uint64           // C - defines how many elements are in a chunk for a validator min or max span slice.
 	validatorChunkSize uint64           // K - defines how many validators' chunks we store in a single flat byte slice on disk.
 	historyLength      primitives.Epoch // H - defines how many epochs we keep of min or max spans.
+	elementWidth       ElementWidth     // W - defines the width of min or max span elements, 16 bits if unset.
 }
 
+// ElementWidth is the width, in bits, of the elements stored in min and max span chunks.
+// Spans store distances between epochs, so 16 bits elements cannot represent a history
+// longer than 65535 epochs. Deployments needing a deeper attesting history can opt into
+// 32 bits elements, at the cost of doubling the size of the chunks.
+type ElementWidth uint8
+
+const (
+	ElementWidth16 ElementWidth = 16
+	ElementWidth32 ElementWidth = 32
+)
+
 // ChunkSize returns the chunk size.
 func (p *Parameters) ChunkSize() uint64 {
 	return p.chunkSize
@@ -31,6 +47,53 @@ func (p *Parameters) HistoryLength() primitives.Epoch {
 	return p.historyLength
 }
 
+// ElementWidth returns the width of min and max span elements.
+func (p *Parameters) ElementWidth() ElementWidth {
+	if p.elementWidth == 0 {
+		return ElementWidth16
+	}
+
+	return p.elementWidth
+}
+
+// WithElementWidth returns a copy of the parameters using min and max span elements of the given width.
+// A zero width selects 16 bits elements.
+func (p *Parameters) WithElementWidth(width ElementWidth) (*Parameters, error) {
+	if width == 0 {
+		width = ElementWidth16
+	}
+
+	if err := validateElementWidth(width, p.historyLength); err != nil {
+		return nil, err
+	}
+
+	params := *p
+	params.elementWidth = width
+	return &params, nil
+}
+
+// String returns a summary of the parameters.
+func (p *Parameters) String() string {
+	return fmt.Sprintf(
+		"chunkSize=%d, validatorChunkSize=%d, historyLength=%d, elementWidth=%d",
+		p.chunkSize, p.validatorChunkSize, p.historyLength, p.ElementWidth(),
+	)
+}
+
+// Returns an error if the element width is not supported, or if it is too narrow
+// to represent the distances between epochs within the history length.
+func validateElementWidth(width ElementWidth, historyLength primitives.Epoch) error {
+	if width != ElementWidth16 && width != ElementWidth32 {
+		return errors.Errorf("unsupported element width %d", width)
+	}
+
+	if width == ElementWidth16 && historyLength > math.MaxUint16 {
+		return errors.Errorf("history length %d is too long for %d bits elements", historyLength, width)
+	}
+
+	return nil
+}
+
 // DefaultParams defines default values for slasher's important parameters, defined
 // based on optimization analysis for best and worst case scenarios for
 // slasher's performance.
@@ -40,11 +103,13 @@ func (p *Parameters) HistoryLength() primitives.Epoch {
 // See: https://hackmd.io/@sproul/min-max-slasher#1D-vs-2D for more information.
 // We decide to keep 4096 epochs worth of data in each validator's min max spans.
 func DefaultParams() *Parameters {
-	return &Parameters{
-		chunkSize:          16,
-		validatorChunkSize: 256,
-		historyLength:      4096,
+	params, err := NewParameters(16, 256, 4096, ElementWidth16)
+	if err != nil {
+		// The default values satisfy all invariants, this should never happen.
+		panic(fmt.Sprintf("invalid default slasher parameters: %v", err))
 	}
+
+	return params
 }
 
 func NewParams(chunkSize, validatorChunkSize uint64, historyLength primitives.Epoch) *Parameters {
@@ -55,6 +120,44 @@ func NewParams(chunkSize, validatorChunkSize uint64, historyLength primitives.Ep
 	}
 }
 
+// NewParameters returns slasher parameters after checking their invariants:
+// all values must be non-zero, the history length must be a multiple of the chunk size
+// so that the min and max spans of a validator are split into a whole number of chunks,
+// and the element width must be wide enough for the history length. A zero element width
+// selects 16 bits elements, consistently with the zero value of `Parameters`.
+func NewParameters(chunkSize, validatorChunkSize, historyLength uint64, elementWidth ElementWidth) (*Parameters, error) {
+	if elementWidth == 0 {
+		elementWidth = ElementWidth16
+	}
+
+	if chunkSize == 0 {
+		return nil, errors.New("chunk size must be greater than 0")
+	}
+
+	if validatorChunkSize == 0 {
+		return nil, errors.New("validator chunk size must be greater than 0")
+	}
+
+	if historyLength == 0 {
+		return nil, errors.New("history length must be greater than 0")
+	}
+
+	if historyLength%chunkSize != 0 {
+		return nil, errors.Errorf("history length %d must be a multiple of chunk size %d", historyLength, chunkSize)
+	}
+
+	if err := validateElementWidth(elementWidth, primitives.Epoch(historyLength)); err != nil {
+		return nil, err
+	}
+
+	return &Parameters{
+		chunkSize:          chunkSize,
+		validatorChunkSize: validatorChunkSize,
+		historyLength:      primitives.Epoch(historyLength),
+		elementWidth:       elementWidth,
+	}, nil
+}
+
 // ChunkIndex Validator min and max spans are split into chunks of length C = chunkSize.
 // That is, if we are keeping N epochs worth of attesting history, finding what
 // chunk a certain epoch, e, falls into can be computed as (e % N) / C. For example,
@@ -175,3 +278,14 @@ func (p *Parameters) ValidatorIndexesInChunk(validatorChunkIndex uint64) []primi
 
 	return validatorIndices
 }
+
+// Number of uint16 words used to store a single min or max span element.
+func (p *Parameters) elementWords() uint64 {
+	return uint64(p.ElementWidth()) / 16
+}
+
+// Number of uint16 words of a min or max span chunk, that is C*K elements
+// for C = chunkSize and K = validatorChunkSize, each of them stored on W/16 words.
+func (p *Parameters) chunkLength() uint64 {
+	return p.chunkSize * p.validatorChunkSize * p.elementWords()
+}
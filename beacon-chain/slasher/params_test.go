@@ -7,6 +7,7 @@ import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
 func TestDefaultParams(t *testing.T) {
@@ -16,6 +17,117 @@ func TestDefaultParams(t *testing.T) {
 	assert.Equal(t, true, def.historyLength > 0)
 }
 
+func TestNewParameters(t *testing.T) {
+	tests := []struct {
+		name               string
+		chunkSize          uint64
+		validatorChunkSize uint64
+		historyLength      uint64
+		elementWidth       ElementWidth
+		wantElementWidth   ElementWidth
+		wantErr            string
+	}{
+		{
+			name:               "valid",
+			chunkSize:          2,
+			validatorChunkSize: 3,
+			historyLength:      8,
+			elementWidth:       ElementWidth16,
+			wantElementWidth:   ElementWidth16,
+		},
+		{
+			name:               "zero element width selects 16 bits elements",
+			chunkSize:          2,
+			validatorChunkSize: 3,
+			historyLength:      8,
+			elementWidth:       0,
+			wantElementWidth:   ElementWidth16,
+		},
+		{
+			name:               "zero chunk size",
+			chunkSize:          0,
+			validatorChunkSize: 3,
+			historyLength:      8,
+			elementWidth:       ElementWidth16,
+			wantErr:            "chunk size must be greater than 0",
+		},
+		{
+			name:               "zero validator chunk size",
+			chunkSize:          2,
+			validatorChunkSize: 0,
+			historyLength:      8,
+			elementWidth:       ElementWidth16,
+			wantErr:            "validator chunk size must be greater than 0",
+		},
+		{
+			name:               "zero history length",
+			chunkSize:          2,
+			validatorChunkSize: 3,
+			historyLength:      0,
+			elementWidth:       ElementWidth16,
+			wantErr:            "history length must be greater than 0",
+		},
+		{
+			name:               "history length not a multiple of chunk size",
+			chunkSize:          3,
+			validatorChunkSize: 3,
+			historyLength:      8,
+			elementWidth:       ElementWidth16,
+			wantErr:            "history length 8 must be a multiple of chunk size 3",
+		},
+		{
+			name:               "unsupported element width",
+			chunkSize:          2,
+			validatorChunkSize: 3,
+			historyLength:      8,
+			elementWidth:       24,
+			wantErr:            "unsupported element width 24",
+		},
+		{
+			name:               "history length too long for 16 bits elements",
+			chunkSize:          16,
+			validatorChunkSize: 3,
+			historyLength:      65536,
+			elementWidth:       ElementWidth16,
+			wantErr:            "history length 65536 is too long for 16 bits elements",
+		},
+		{
+			name:               "history length longer than 16 bits with 32 bits elements",
+			chunkSize:          16,
+			validatorChunkSize: 3,
+			historyLength:      65536,
+			elementWidth:       ElementWidth32,
+			wantElementWidth:   ElementWidth32,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewParameters(tt.chunkSize, tt.validatorChunkSize, tt.historyLength, tt.elementWidth)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.chunkSize, p.ChunkSize())
+			assert.Equal(t, tt.validatorChunkSize, p.ValidatorChunkSize())
+			assert.Equal(t, primitives.Epoch(tt.historyLength), p.HistoryLength())
+			assert.Equal(t, tt.wantElementWidth, p.ElementWidth())
+		})
+	}
+}
+
+func TestParameters_Accessors(t *testing.T) {
+	p := NewParams(2, 3, 4)
+	assert.Equal(t, uint64(2), p.ChunkSize())
+	assert.Equal(t, uint64(3), p.ValidatorChunkSize())
+	assert.Equal(t, primitives.Epoch(4), p.HistoryLength())
+}
+
+func TestParameters_String(t *testing.T) {
+	assert.Equal(t, "chunkSize=16, validatorChunkSize=256, historyLength=4096, elementWidth=16", DefaultParams().String())
+}
+
 func TestParams_cellIndex(t *testing.T) {
 	type args struct {
 		validatorIndex primitives.ValidatorIndex
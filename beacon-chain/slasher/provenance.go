@@ -0,0 +1,79 @@
+package slasher
+
+import (
+	"context"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// SlashingProvenance records which attestation records and span chunk cell
+// led to the detection of a surround vote, for post-incident audits.
+type SlashingProvenance struct {
+	// Kind of the span chunks slice which detected the slashing.
+	Kind slashertypes.ChunkKind
+	// ExistingRecord is the attestation record, read from the database, which surrounds
+	// or is surrounded by the incoming attestation.
+	ExistingRecord *slashertypes.IndexedAttestationWrapper
+	// IncomingRecord is the incoming attestation.
+	IncomingRecord *slashertypes.IndexedAttestationWrapper
+	// ViolatingEpoch is the epoch of the span cell read for the detection,
+	// that is the source epoch of the incoming attestation.
+	ViolatingEpoch primitives.Epoch
+	// SpanTarget is the target epoch stored in the span cell at the violating epoch.
+	SpanTarget primitives.Epoch
+	// ValidatorChunkIndex is the validator chunk index of the span chunk cell.
+	ValidatorChunkIndex uint64
+}
+
+// CheckSlashableWithProvenance behaves as `CheckSlashable`, but also returns the provenance
+// of the detected slashing. Both returned values are nil if the attestation is not slashable.
+func (m *MinSpanChunksSlice) CheckSlashableWithProvenance(
+	ctx context.Context,
+	recordReader AttestationRecordReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (ethpb.AttSlashing, *SlashingProvenance, error) {
+	vote, err := m.checkSlashable(ctx, recordReader, validatorIdx, incomingAttWrapper)
+	if err != nil || vote == nil {
+		return nil, nil, err
+	}
+
+	provenance := newSlashingProvenance(m.params, slashertypes.MinSpan, validatorIdx, incomingAttWrapper, vote)
+	return vote.slashing, provenance, nil
+}
+
+// CheckSlashableWithProvenance behaves as `CheckSlashable`, but also returns the provenance
+// of the detected slashing. Both returned values are nil if the attestation is not slashable.
+func (m *MaxSpanChunksSlice) CheckSlashableWithProvenance(
+	ctx context.Context,
+	recordReader AttestationRecordReader,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (ethpb.AttSlashing, *SlashingProvenance, error) {
+	vote, err := m.checkSlashable(ctx, recordReader, validatorIdx, incomingAttWrapper)
+	if err != nil || vote == nil {
+		return nil, nil, err
+	}
+
+	provenance := newSlashingProvenance(m.params, slashertypes.MaxSpan, validatorIdx, incomingAttWrapper, vote)
+	return vote.slashing, provenance, nil
+}
+
+func newSlashingProvenance(
+	params *Parameters,
+	kind slashertypes.ChunkKind,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+	vote *surroundVote,
+) *SlashingProvenance {
+	return &SlashingProvenance{
+		Kind:                kind,
+		ExistingRecord:      vote.existingAttWrapper,
+		IncomingRecord:      incomingAttWrapper,
+		ViolatingEpoch:      incomingAttWrapper.IndexedAttestation.GetData().Source.Epoch,
+		SpanTarget:          vote.spanTarget,
+		ValidatorChunkIndex: params.validatorChunkIndex(validatorIdx),
+	}
+}
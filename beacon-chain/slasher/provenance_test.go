@@ -0,0 +1,85 @@
+package slasher
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestMinSpanChunksSlice_CheckSlashableWithProvenance(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	validatorIdx := primitives.ValidatorIndex(3)
+
+	// Mark an attestation with (source 1, target 2) as attested, and save its record.
+	chunk := EmptyMinSpanChunksSlice(params)
+	_, err := chunk.Update(0, 2, validatorIdx, 2, 2)
+	require.NoError(t, err)
+
+	attRecord := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{uint64(validatorIdx)}, []byte{1})
+	require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{attRecord}))
+
+	// A non slashable attestation has no provenance.
+	slashing, provenance, err := chunk.CheckSlashableWithProvenance(ctx, slasherDB, validatorIdx, createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil))
+	require.NoError(t, err)
+	require.Equal(t, nil, slashing)
+	require.Equal(t, true, provenance == nil)
+
+	// The surrounding vote (source 0, target 3) surrounds the recorded attestation.
+	surroundingVote := createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, nil, nil)
+	slashing, provenance, err = chunk.CheckSlashableWithProvenance(ctx, slasherDB, validatorIdx, surroundingVote)
+	require.NoError(t, err)
+	require.Equal(t, false, reflect.ValueOf(slashing).IsNil())
+	require.NotNil(t, provenance)
+
+	require.Equal(t, slashertypes.MinSpan, provenance.Kind)
+	require.Equal(t, attRecord.DataRoot, provenance.ExistingRecord.DataRoot)
+	require.Equal(t, surroundingVote, provenance.IncomingRecord)
+	require.Equal(t, primitives.Epoch(0), provenance.ViolatingEpoch)
+	require.Equal(t, primitives.Epoch(2), provenance.SpanTarget)
+	require.Equal(t, uint64(1), provenance.ValidatorChunkIndex)
+}
+
+func TestMaxSpanChunksSlice_CheckSlashableWithProvenance(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	validatorIdx := primitives.ValidatorIndex(1)
+
+	// Mark an attestation with (source 0, target 3) as attested, and save its record.
+	chunk := EmptyMaxSpanChunksSlice(params)
+	_, err := chunk.Update(0, 3, validatorIdx, 0, 3)
+	require.NoError(t, err)
+
+	attRecord := createAttestationWrapperEmptySig(t, version.Phase0, 0, 3, []uint64{uint64(validatorIdx)}, []byte{1})
+	require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{attRecord}))
+
+	// The surrounded vote (source 1, target 2) is surrounded by the recorded attestation.
+	surroundedVote := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, nil, nil)
+	slashing, provenance, err := chunk.CheckSlashableWithProvenance(ctx, slasherDB, validatorIdx, surroundedVote)
+	require.NoError(t, err)
+	require.Equal(t, false, reflect.ValueOf(slashing).IsNil())
+	require.NotNil(t, provenance)
+
+	require.Equal(t, slashertypes.MaxSpan, provenance.Kind)
+	require.Equal(t, attRecord.DataRoot, provenance.ExistingRecord.DataRoot)
+	require.Equal(t, surroundedVote, provenance.IncomingRecord)
+	require.Equal(t, primitives.Epoch(1), provenance.ViolatingEpoch)
+	require.Equal(t, primitives.Epoch(3), provenance.SpanTarget)
+	require.Equal(t, uint64(0), provenance.ValidatorChunkIndex)
+}
@@ -30,7 +30,7 @@ func (s *Service) receiveAttestations(ctx context.Context, indexedAttsChan chan
 	for {
 		select {
 		case att := <-indexedAttsChan:
-			if !validateAttestationIntegrity(att) {
+			if validateAttestationIntegrity(att) != nil {
 				continue
 			}
 			dataRoot, err := att.GetData().HashTreeRoot()
@@ -114,12 +114,7 @@ func (s *Service) processAttestations(
 
 	// Take all the attestations in the queue and filter out
 	// those which are valid now and valid in the future.
-	validAttestations, validInFutureAttestations, numDropped := s.filterAttestations(attestations, currentEpoch)
-
-	// Increase corresponding prometheus metrics.
-	deferredAttestationsTotal.Add(float64(len(validInFutureAttestations)))
-	droppedAttestationsTotal.Add(float64(numDropped))
-	processedAttestationsTotal.Add(float64(len(validAttestations)))
+	validAttestations, validInFutureAttestations, numDropped := s.filterAttestationsWithMetrics(attestations, currentEpoch)
 
 	// We add back those attestations that are valid in the future to the queue.
 	s.attsQueue.extend(validInFutureAttestations)
@@ -142,7 +137,9 @@ func (s *Service) processAttestations(
 	start := time.Now()
 
 	// Check for attestations slashings (double, surrounding, surrounded votes).
+	s.detectionLock.Lock()
 	slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAttestations)
+	s.detectionLock.Unlock()
 	if err != nil {
 		log.WithError(err).Error(couldNotCheckSlashableAtt)
 		return nil
@@ -166,6 +163,20 @@ func (s *Service) processAttestations(
 	return processedAttesterSlashings
 }
 
+// Filters attestations as `filterAttestations` does, and increases
+// the deferred, dropped and processed attestations metrics accordingly.
+func (s *Service) filterAttestationsWithMetrics(
+	attWrappers []*slashertypes.IndexedAttestationWrapper, currentEpoch primitives.Epoch,
+) (valid, validInFuture []*slashertypes.IndexedAttestationWrapper, numDropped int) {
+	valid, validInFuture, numDropped = s.filterAttestations(attWrappers, currentEpoch)
+
+	deferredAttestationsTotal.Add(float64(len(validInFuture)))
+	droppedAttestationsTotal.Add(float64(numDropped))
+	processedAttestationsTotal.Add(float64(len(valid)))
+
+	return valid, validInFuture, numDropped
+}
+
 // Process queued blocks every time an epoch ticker fires. We retrieve
 // these blocks from a queue, then perform double proposal detection.
 func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan primitives.Slot) {
@@ -245,9 +256,7 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 		"currentEpoch":          currentEpoch,
 		"pruningAllBeforeEpoch": maxPruningEpoch,
 	}).Info("Pruning old attestations and proposals for slasher")
-	numPrunedAtts, err := s.serviceCfg.Database.PruneAttestationsAtEpoch(
-		ctx, maxPruningEpoch,
-	)
+	numPrunedAtts, err := s.Prune(ctx, maxPruningEpoch+1)
 	if err != nil {
 		return errors.Wrap(err, "Could not prune attestations")
 	}
@@ -268,3 +277,137 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	log.WithFields(fields).Info("Done pruning old attestations and proposals for slasher")
 	return nil
 }
+
+// Prune removes all slasher attestation data older than `beforeEpoch`. The min and max span
+// cells of all validators for these epochs are first reset to their neutral element, then the
+// attestation records with a target epoch strictly lower than `beforeEpoch` are deleted. These
+// steps are not performed in a single database transaction. Instead, this order guarantees span
+// cells never refer to deleted attestation records, even if pruning is interrupted, and every step
+// is idempotent: resetting a neutral cell or deleting missing records is a no-op. An interrupted
+// call can therefore be safely re-run with the same `beforeEpoch`.
+// Span cells are reset one validator chunk at a time, only for epochs still within the history
+// window ending at the latest epoch processed for this validator chunk, since older cells were
+// already reused for more recent epochs. Chunks absent from the database are skipped.
+func (s *Service) Prune(ctx context.Context, beforeEpoch primitives.Epoch) (uint, error) {
+	if beforeEpoch == 0 {
+		// There is nothing older than the genesis epoch.
+		return 0, nil
+	}
+
+	s.detectionLock.Lock()
+	defer s.detectionLock.Unlock()
+
+	// Determine, for each validator chunk, the latest epoch processed by the slasher.
+	latestEpochByValidatorChunkIndex := make(map[uint64]primitives.Epoch)
+	for validatorIndex, epoch := range s.latestEpochUpdatedForValidator {
+		validatorChunkIndex := s.params.validatorChunkIndex(validatorIndex)
+		if latestEpoch, ok := latestEpochByValidatorChunkIndex[validatorChunkIndex]; !ok || epoch > latestEpoch {
+			latestEpochByValidatorChunkIndex[validatorChunkIndex] = epoch
+		}
+	}
+
+	for validatorChunkIndex, latestEpoch := range latestEpochByValidatorChunkIndex {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		if err := s.resetSpansBefore(ctx, validatorChunkIndex, latestEpoch, beforeEpoch); err != nil {
+			return 0, errors.Wrapf(err, "could not reset spans for validator chunk index %d", validatorChunkIndex)
+		}
+	}
+
+	numPruned, err := s.serviceCfg.Database.PruneAttestationsAtEpoch(ctx, beforeEpoch-1)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not prune attestations")
+	}
+
+	return numPruned, nil
+}
+
+// resetSpansBefore resets to their neutral element the min and max span cells of all validators
+// of the validator chunk index for epochs strictly lower than `beforeEpoch`, still within the
+// history window ending at `latestEpoch`. Only the chunks existing in the database are updated.
+func (s *Service) resetSpansBefore(
+	ctx context.Context,
+	validatorChunkIndex uint64,
+	latestEpoch, beforeEpoch primitives.Epoch,
+) error {
+	// The oldest epoch still within the history window.
+	firstEpochToReset := primitives.Epoch(0)
+	if latestEpoch >= s.params.historyLength {
+		firstEpochToReset = latestEpoch + 1 - s.params.historyLength
+	}
+
+	lastEpochToReset := min(beforeEpoch-1, latestEpoch)
+	if firstEpochToReset > lastEpochToReset {
+		return nil
+	}
+
+	// Determine the chunk indexes containing the epochs to reset.
+	chunkIndexes := make([]uint64, 0)
+	chunkIndexesMap := make(map[uint64]bool)
+	for epoch := firstEpochToReset; epoch <= lastEpochToReset; epoch++ {
+		chunkIndex := s.params.chunkIndex(epoch)
+		if !chunkIndexesMap[chunkIndex] {
+			chunkIndexesMap[chunkIndex] = true
+			chunkIndexes = append(chunkIndexes, chunkIndex)
+		}
+	}
+
+	chunkKeys := make([][]byte, 0, len(chunkIndexes))
+	for _, chunkIndex := range chunkIndexes {
+		chunkKeys = append(chunkKeys, s.params.flatSliceID(validatorChunkIndex, chunkIndex))
+	}
+
+	validatorIndexes := s.params.ValidatorIndexesInChunk(validatorChunkIndex)
+
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		rawChunks, chunksExist, err := s.serviceCfg.Database.LoadSlasherChunks(ctx, kind, chunkKeys)
+		if err != nil {
+			return errors.Wrapf(err, "could not load %s chunks", kind)
+		}
+
+		chunkByChunkIndex := make(map[uint64]Chunker, len(chunkIndexes))
+		for i, chunkIndex := range chunkIndexes {
+			if !chunksExist[i] {
+				continue
+			}
+
+			var chunk Chunker
+			switch kind {
+			case slashertypes.MinSpan:
+				chunk, err = MinChunkSpansSliceFrom(s.params, rawChunks[i])
+			case slashertypes.MaxSpan:
+				chunk, err = MaxChunkSpansSliceFrom(s.params, rawChunks[i])
+			}
+			if err != nil {
+				return errors.Wrapf(err, "could not initialize %s chunk for chunk index %d", kind, chunkIndex)
+			}
+
+			chunkByChunkIndex[chunkIndex] = chunk
+		}
+
+		if len(chunkByChunkIndex) == 0 {
+			continue
+		}
+
+		for epoch := firstEpochToReset; epoch <= lastEpochToReset; epoch++ {
+			chunk, ok := chunkByChunkIndex[s.params.chunkIndex(epoch)]
+			if !ok {
+				continue
+			}
+
+			for _, validatorIndex := range validatorIndexes {
+				if err := setChunkRawDistance(s.params, chunk.Chunk(), validatorIndex, epoch, chunk.NeutralElement()); err != nil {
+					return errors.Wrapf(err, "could not reset chunk for validator index %d at epoch %d", validatorIndex, epoch)
+				}
+			}
+		}
+
+		if err := s.saveChunksToDisk(ctx, kind, map[uint64]map[uint64]Chunker{validatorChunkIndex: chunkByChunkIndex}); err != nil {
+			return errors.Wrapf(err, "could not save %s chunks", kind)
+		}
+	}
+
+	return nil
+}
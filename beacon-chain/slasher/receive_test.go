@@ -205,6 +205,208 @@ func TestService_pruneSlasherDataWithinSlidingWindow_ProposalsPruned(t *testing.
 	}
 }
 
+func TestService_Prune(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+
+	// 2 validators per chunk, 2 epochs per chunk, 8 epochs worth of history.
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      8,
+	}
+
+	// The slasher processed attestations up to epoch 10.
+	currentEpoch := primitives.Epoch(10)
+
+	s := &Service{
+		params: params,
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+		latestEpochUpdatedForValidator: map[primitives.ValidatorIndex]primitives.Epoch{
+			0: currentEpoch,
+			1: currentEpoch,
+		},
+	}
+
+	// Setup attestations for 2 validators at each epoch for epochs 4, 5, 6, 7.
+	err := slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapperEmptySig(t, version.Phase0, 3, 4, []uint64{0}, bytesutil.PadTo([]byte("4a"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 3, 4, []uint64{1}, bytesutil.PadTo([]byte("4b"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 4, 5, []uint64{0}, bytesutil.PadTo([]byte("5a"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 4, 5, []uint64{1}, bytesutil.PadTo([]byte("5b"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 5, 6, []uint64{0}, bytesutil.PadTo([]byte("6a"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 5, 6, []uint64{1}, bytesutil.PadTo([]byte("6b"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 6, 7, []uint64{0}, bytesutil.PadTo([]byte("7a"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 6, 7, []uint64{1}, bytesutil.PadTo([]byte("7b"), 32)),
+	})
+	require.NoError(t, err)
+
+	// Fill the span chunks of the validator chunk with a non-neutral value,
+	// except the chunk containing epochs 4 and 5 which is missing.
+	const spanValue = uint16(5)
+	chunkIndexes := []uint64{0, 1, 2, 3}
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		chunkKeys := make([][]byte, 0, len(chunkIndexes))
+		chunks := make([][]uint16, 0, len(chunkIndexes))
+		for _, chunkIndex := range chunkIndexes {
+			if chunkIndex == params.chunkIndex(4) {
+				continue
+			}
+
+			chunk := make([]uint16, params.chunkSize*params.validatorChunkSize)
+			for i := range chunk {
+				chunk[i] = spanValue
+			}
+
+			chunkKeys = append(chunkKeys, params.flatSliceID(0, chunkIndex))
+			chunks = append(chunks, chunk)
+		}
+
+		require.NoError(t, slasherDB.SaveSlasherChunks(ctx, kind, chunkKeys, chunks))
+	}
+
+	numPruned, err := s.Prune(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, uint(4), numPruned)
+
+	// Attestation records with a target epoch lower than 6 should be pruned.
+	for _, epoch := range []primitives.Epoch{4, 5} {
+		for _, validatorIndex := range []primitives.ValidatorIndex{0, 1} {
+			att, err := slasherDB.AttestationRecordForValidator(ctx, validatorIndex, epoch)
+			require.NoError(t, err)
+			require.Equal(t, true, att == nil)
+		}
+	}
+
+	for _, epoch := range []primitives.Epoch{6, 7} {
+		for _, validatorIndex := range []primitives.ValidatorIndex{0, 1} {
+			att, err := slasherDB.AttestationRecordForValidator(ctx, validatorIndex, epoch)
+			require.NoError(t, err)
+			require.NotNil(t, att)
+		}
+	}
+
+	// Span cells for epochs 3, 4 and 5 should be reset, the other ones should be preserved.
+	// The missing chunk should not be written.
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		_, chunksExist, err := slasherDB.LoadSlasherChunks(ctx, kind, [][]byte{params.flatSliceID(0, params.chunkIndex(4))})
+		require.NoError(t, err)
+		require.Equal(t, false, chunksExist[0])
+
+		chunkByChunkIndex, err := s.loadChunksFromDisk(ctx, 0, kind, chunkIndexes)
+		require.NoError(t, err)
+
+		for epoch := primitives.Epoch(3); epoch <= currentEpoch; epoch++ {
+			chunk := chunkByChunkIndex[params.chunkIndex(epoch)]
+
+			expected := uint32(spanValue)
+			if epoch < 6 {
+				expected = chunk.NeutralElement()
+			}
+
+			for _, validatorIndex := range []primitives.ValidatorIndex{0, 1} {
+				actual := chunkElement(params, chunk.Chunk(), params.cellIndex(validatorIndex, epoch))
+				require.Equal(t, expected, actual, "kind %s, epoch %d, validator %d", kind, epoch, validatorIndex)
+			}
+		}
+	}
+}
+
+func TestService_Prune_Rerun(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+
+	// 2 validators per chunk, 2 epochs per chunk, 8 epochs worth of history.
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+		historyLength:      8,
+	}
+
+	// The slasher processed attestations up to epoch 10.
+	currentEpoch := primitives.Epoch(10)
+
+	s := &Service{
+		params: params,
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+		latestEpochUpdatedForValidator: map[primitives.ValidatorIndex]primitives.Epoch{
+			0: currentEpoch,
+		},
+	}
+
+	// Setup attestations for validator 0 at epochs 4, 5, 6 and 7.
+	err := slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapperEmptySig(t, version.Phase0, 3, 4, []uint64{0}, bytesutil.PadTo([]byte("4"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 4, 5, []uint64{0}, bytesutil.PadTo([]byte("5"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 5, 6, []uint64{0}, bytesutil.PadTo([]byte("6"), 32)),
+		createAttestationWrapperEmptySig(t, version.Phase0, 6, 7, []uint64{0}, bytesutil.PadTo([]byte("7"), 32)),
+	})
+	require.NoError(t, err)
+
+	// Fill all the span chunks of the validator chunk with a non-neutral value.
+	const spanValue = uint16(5)
+	chunkIndexes := []uint64{0, 1, 2, 3}
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		chunkKeys := make([][]byte, 0, len(chunkIndexes))
+		chunks := make([][]uint16, 0, len(chunkIndexes))
+		for _, chunkIndex := range chunkIndexes {
+			chunk := make([]uint16, params.chunkSize*params.validatorChunkSize)
+			for i := range chunk {
+				chunk[i] = spanValue
+			}
+
+			chunkKeys = append(chunkKeys, params.flatSliceID(0, chunkIndex))
+			chunks = append(chunks, chunk)
+		}
+
+		require.NoError(t, slasherDB.SaveSlasherChunks(ctx, kind, chunkKeys, chunks))
+	}
+
+	requirePruned := func() {
+		for epoch := primitives.Epoch(4); epoch <= 7; epoch++ {
+			att, err := slasherDB.AttestationRecordForValidator(ctx, 0, epoch)
+			require.NoError(t, err)
+			require.Equal(t, epoch < 6, att == nil, "epoch %d", epoch)
+		}
+
+		for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+			chunkByChunkIndex, err := s.loadChunksFromDisk(ctx, 0, kind, chunkIndexes)
+			require.NoError(t, err)
+
+			for epoch := primitives.Epoch(3); epoch <= currentEpoch; epoch++ {
+				chunk := chunkByChunkIndex[params.chunkIndex(epoch)]
+
+				expected := uint32(spanValue)
+				if epoch < 6 {
+					expected = chunk.NeutralElement()
+				}
+
+				actual := chunkElement(params, chunk.Chunk(), params.cellIndex(0, epoch))
+				require.Equal(t, expected, actual, "kind %s, epoch %d", kind, epoch)
+			}
+		}
+	}
+
+	// Simulate a pruning interrupted after resetting the spans, but before deleting the attestation records.
+	require.NoError(t, s.resetSpansBefore(ctx, 0, currentEpoch, 6))
+
+	// Re-running the pruning completes it.
+	numPruned, err := s.Prune(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), numPruned)
+	requirePruned()
+
+	// Re-running a completed pruning is a no-op.
+	numPruned, err = s.Prune(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), numPruned)
+	requirePruned()
+}
+
 func TestSlasher_receiveAttestations_OnlyValidAttestations(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	s := &Service{
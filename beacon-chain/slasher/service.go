@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -42,6 +43,15 @@ type ServiceConfig struct {
 	HeadStateFetcher        blockchain.HeadFetcher
 	SyncChecker             beaconChainSync.Checker
 	ClockWaiter             startup.ClockWaiter
+	// Detected attester slashings slashing fewer validators than this threshold are suppressed.
+	// A zero value disables the threshold.
+	MinSlashedValidators uint64
+	// Attestations which are not slashable, but would be if their target epoch was off by at most
+	// this many epochs, are reported as near slashable. A zero value disables the warning.
+	NearSlashableMargin uint64
+	// Width of the elements stored in min and max span chunks. A zero value selects 16 bits elements.
+	// 32 bits elements are required for histories longer than 65535 epochs.
+	ElementWidth ElementWidth
 }
 
 // Service defining a slasher implementation as part of
@@ -59,15 +69,23 @@ type Service struct {
 	attsSlotTicker                 *slots.SlotTicker
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
+	coverageSlotTicker             *slots.SlotTicker
 	latestEpochUpdatedForValidator map[primitives.ValidatorIndex]primitives.Epoch
-	wg                             sync.WaitGroup
+	// Serializes the writers of span chunks and `latestEpochUpdatedForValidator`.
+	detectionLock sync.Mutex
+	wg            sync.WaitGroup
 }
 
 // New instantiates a new slasher from configuration values.
 func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
+	params, err := DefaultParams().WithElementWidth(srvCfg.ElementWidth)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not configure slasher parameters")
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
-		params:                         DefaultParams(),
+		params:                         params,
 		serviceCfg:                     srvCfg,
 		indexedAttsChan:                make(chan ethpb.IndexedAtt, 1),
 		beaconBlockHeadersChan:         make(chan *ethpb.SignedBeaconBlockHeader, 1),
@@ -151,6 +169,7 @@ func (s *Service) run() {
 	s.attsSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.blocksSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.pruningSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	s.coverageSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 
 	s.wg.Add(1)
 	go s.processQueuedAttestations(s.ctx, s.attsSlotTicker.C())
@@ -160,6 +179,9 @@ func (s *Service) run() {
 
 	s.wg.Add(1)
 	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
+
+	s.wg.Add(1)
+	go s.scanHistoryCoverage(s.ctx, s.coverageSlotTicker.C())
 }
 
 // Stop the slasher service.
@@ -176,6 +198,9 @@ func (s *Service) Stop() error {
 	if s.pruningSlotTicker != nil {
 		s.pruningSlotTicker.Done()
 	}
+	if s.coverageSlotTicker != nil {
+		s.coverageSlotTicker.Done()
+	}
 	// Flush the latest epoch written map to disk.
 	start := time.Now()
 	// New context as the service context has already been canceled.
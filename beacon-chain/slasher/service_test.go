@@ -57,7 +57,21 @@ func TestService_StartStop_ChainInitialized(t *testing.T) {
 	srv.attsSlotTicker = &slots.SlotTicker{}
 	srv.blocksSlotTicker = &slots.SlotTicker{}
 	srv.pruningSlotTicker = &slots.SlotTicker{}
+	srv.coverageSlotTicker = &slots.SlotTicker{}
 	require.NoError(t, srv.Stop())
 	require.NoError(t, srv.Status())
 	require.LogsContain(t, hook, "received chain initialization")
 }
+
+func TestNew_ElementWidth(t *testing.T) {
+	srv, err := New(context.Background(), &ServiceConfig{})
+	require.NoError(t, err)
+	require.Equal(t, ElementWidth16, srv.params.ElementWidth())
+
+	srv, err = New(context.Background(), &ServiceConfig{ElementWidth: ElementWidth32})
+	require.NoError(t, err)
+	require.Equal(t, ElementWidth32, srv.params.ElementWidth())
+
+	_, err = New(context.Background(), &ServiceConfig{ElementWidth: 24})
+	require.ErrorContains(t, "unsupported element width 24", err)
+}
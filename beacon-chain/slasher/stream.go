@@ -0,0 +1,217 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Maximum number of attestations processed at once by `Run`.
+	streamBatchSize = 4096
+
+	// Maximum time an attestation received by `Run` waits before being processed
+	// if its batch is not yet full.
+	streamFlushInterval = time.Second
+
+	// Maximum number of attestations deferred to a future epoch by `Run`.
+	streamMaxDeferred = streamBatchSize
+
+	// Maximum number of epochs an attestation stays deferred by `Run` before being dropped.
+	streamMaxDeferredEpochs = primitives.Epoch(2)
+)
+
+// An attestation deferred to a future epoch by `Run`, with the epoch it was deferred at.
+type deferredAttestation struct {
+	attWrapper *slashertypes.IndexedAttestationWrapper
+	deferredAt primitives.Epoch
+}
+
+// Run consumes indexed attestations from `in`, performs slashing detection on them
+// by batches, and emits any found attester slashing to `out`. The current epoch
+// is read from `clock`.
+//
+// A batch is processed as soon as it contains `streamBatchSize` attestations, or
+// every `streamFlushInterval` otherwise. While a batch is being processed and its
+// slashings emitted, no attestation is read from `in`: a slow consumer of `out`
+// thus applies backpressure to the producer of `in` instead of letting batches
+// accumulate in memory.
+//
+// Attestations with a target epoch in the future are kept in a separate queue of at
+// most `streamMaxDeferred` attestations, and are only re-checked every
+// `streamFlushInterval`, once their target epoch is reached. Attestations which do
+// not fit in this queue, or stay deferred for more than `streamMaxDeferredEpochs`
+// epochs, are dropped.
+//
+// Batches are processed under the same lock as the attestations queued by the
+// started service, so Run may be used whether the service is started or not.
+//
+// Run returns once `in` is closed and the pending batch is processed, or as soon
+// as the context is canceled. In both cases, it also returns the attestations
+// it received but could not process yet: the ones deferred to a future epoch,
+// and on cancellation, the ones of the pending batch.
+func (s *Service) Run(
+	ctx context.Context,
+	clock *startup.Clock,
+	in <-chan *slashertypes.IndexedAttestationWrapper,
+	out chan<- ethpb.AttSlashing,
+) ([]*slashertypes.IndexedAttestationWrapper, error) {
+	if clock == nil {
+		return nil, errors.New("nil clock")
+	}
+
+	ticker := time.NewTicker(streamFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*slashertypes.IndexedAttestationWrapper, 0, streamBatchSize)
+	deferred := make([]deferredAttestation, 0)
+
+	// Returns the attestations received but not processed yet.
+	pending := func(attWrappers []*slashertypes.IndexedAttestationWrapper) []*slashertypes.IndexedAttestationWrapper {
+		for _, d := range deferred {
+			attWrappers = append(attWrappers, d.attWrapper)
+		}
+
+		return attWrappers
+	}
+
+	// Processes the pending batch together with the deferred attestations whose target epoch is reached.
+	flush := func() error {
+		currentEpoch := slots.ToEpoch(clock.CurrentSlot())
+		ready, stillDeferred := releaseDeferredAttestations(deferred, currentEpoch)
+
+		validInFuture, err := s.processAttestationsBatch(ctx, currentEpoch, append(batch, ready...), out)
+		deferred = deferAttestations(stillDeferred, validInFuture, currentEpoch)
+		batch = make([]*slashertypes.IndexedAttestationWrapper, 0, streamBatchSize)
+		return err
+	}
+
+	for {
+		select {
+		case attWrapper, ok := <-in:
+			if !ok {
+				// The input stream is closed, process what is left and stop.
+				err := flush()
+				return pending(nil), err
+			}
+
+			batch = append(batch, attWrapper)
+			if len(batch) < streamBatchSize {
+				continue
+			}
+
+			// Deferred attestations are only re-checked on the ticker.
+			currentEpoch := slots.ToEpoch(clock.CurrentSlot())
+			validInFuture, err := s.processAttestationsBatch(ctx, currentEpoch, batch, out)
+			deferred = deferAttestations(deferred, validInFuture, currentEpoch)
+			if err != nil {
+				return pending(nil), err
+			}
+
+			batch = make([]*slashertypes.IndexedAttestationWrapper, 0, streamBatchSize)
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return pending(nil), err
+			}
+		case <-ctx.Done():
+			return pending(batch), ctx.Err()
+		}
+	}
+}
+
+// Performs slashing detection on a batch of attestations for the current epoch
+// and sends found slashings to `out`. Attestations which are only valid in the future
+// are returned to the caller, even if an error occurred.
+func (s *Service) processAttestationsBatch(
+	ctx context.Context,
+	currentEpoch primitives.Epoch,
+	batch []*slashertypes.IndexedAttestationWrapper,
+	out chan<- ethpb.AttSlashing,
+) ([]*slashertypes.IndexedAttestationWrapper, error) {
+	if len(batch) == 0 {
+		return nil, nil
+	}
+
+	validAttestations, validInFutureAttestations, _ := s.filterAttestationsWithMetrics(batch, currentEpoch)
+
+	s.detectionLock.Lock()
+	slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAttestations)
+	s.detectionLock.Unlock()
+	if err != nil {
+		return validInFutureAttestations, errors.Wrap(err, couldNotCheckSlashableAtt)
+	}
+
+	for _, slashing := range slashings {
+		select {
+		case out <- slashing:
+		case <-ctx.Done():
+			return validInFutureAttestations, ctx.Err()
+		}
+	}
+
+	return validInFutureAttestations, nil
+}
+
+// Appends attestations to the queue of deferred attestations, dropping the ones
+// which do not fit in it.
+func deferAttestations(
+	deferred []deferredAttestation,
+	attWrappers []*slashertypes.IndexedAttestationWrapper,
+	currentEpoch primitives.Epoch,
+) []deferredAttestation {
+	kept := min(len(attWrappers), max(streamMaxDeferred-len(deferred), 0))
+	for _, attWrapper := range attWrappers[:kept] {
+		deferred = append(deferred, deferredAttestation{attWrapper: attWrapper, deferredAt: currentEpoch})
+	}
+
+	if dropped := len(attWrappers) - kept; dropped > 0 {
+		droppedDeferredAttestationsTotal.Add(float64(dropped))
+		log.WithFields(logrus.Fields{
+			"count":       dropped,
+			"maxDeferred": streamMaxDeferred,
+		}).Warn("Dropped attestations deferred to a future epoch, the deferred queue is full")
+	}
+
+	return deferred
+}
+
+// Splits the queue of deferred attestations into the ones which can be processed at the
+// current epoch, and the ones which are still deferred. Attestations deferred for more
+// than `streamMaxDeferredEpochs` epochs are dropped.
+func releaseDeferredAttestations(
+	deferred []deferredAttestation,
+	currentEpoch primitives.Epoch,
+) (ready []*slashertypes.IndexedAttestationWrapper, stillDeferred []deferredAttestation) {
+	stillDeferred = make([]deferredAttestation, 0, len(deferred))
+	dropped := 0
+	for _, d := range deferred {
+		if d.attWrapper.IndexedAttestation.GetData().Target.Epoch <= currentEpoch {
+			ready = append(ready, d.attWrapper)
+			continue
+		}
+
+		if currentEpoch > d.deferredAt+streamMaxDeferredEpochs {
+			dropped++
+			continue
+		}
+
+		stillDeferred = append(stillDeferred, d)
+	}
+
+	if dropped > 0 {
+		droppedDeferredAttestationsTotal.Add(float64(dropped))
+		log.WithFields(logrus.Fields{
+			"count":             dropped,
+			"maxDeferredEpochs": streamMaxDeferredEpochs,
+		}).Warn("Dropped attestations deferred to a future epoch for too long")
+	}
+
+	return ready, stillDeferred
+}
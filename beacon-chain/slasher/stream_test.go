@@ -0,0 +1,187 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Set the genesis time so that the current epoch is 4.
+	const currentEpoch = primitives.Epoch(4)
+	totalSlots := uint64(currentEpoch) * uint64(params.BeaconConfig().SlotsPerEpoch)
+	secondsSinceGenesis := time.Duration(totalSlots*params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	s, err := New(ctx, &ServiceConfig{Database: dbtest.SetupSlasherDB(t)})
+	require.NoError(t, err)
+	clock := startup.NewClock(time.Now().Add(-secondsSinceGenesis), [32]byte{})
+
+	in := make(chan *slashertypes.IndexedAttestationWrapper)
+	out := make(chan ethpb.AttSlashing)
+	type runResult struct {
+		deferred []*slashertypes.IndexedAttestationWrapper
+		err      error
+	}
+	resultChan := make(chan runResult, 1)
+
+	go func() {
+		deferred, err := s.Run(ctx, clock, in, out)
+		resultChan <- runResult{deferred: deferred, err: err}
+	}()
+
+	// Validator 1 votes twice for target 2 with different block roots: this is a double vote.
+	att1 := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{1}, []byte{1})
+	att2 := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{1}, []byte{2})
+
+	// An attestation from another validator which is not slashable.
+	att3 := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{2}, []byte{1})
+
+	// An attestation with a target in the future, which cannot be processed yet.
+	futureAtt := createAttestationWrapperEmptySig(t, version.Phase0, 1, currentEpoch+1, []uint64{3}, []byte{1})
+
+	in <- att1
+	in <- att2
+	in <- att3
+	in <- futureAtt
+	close(in)
+
+	select {
+	case slashing := <-out:
+		require.DeepEqual(t, []uint64{1}, slashing.FirstAttestation().GetAttestingIndices())
+		require.DeepEqual(t, []uint64{1}, slashing.SecondAttestation().GetAttestingIndices())
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for the slashing")
+	}
+
+	result := <-resultChan
+	require.NoError(t, result.err)
+
+	// The attestation deferred to a future epoch is returned instead of being dropped.
+	require.Equal(t, 1, len(result.deferred))
+	require.Equal(t, futureAtt, result.deferred[0])
+}
+
+func TestService_Run_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s, err := New(ctx, &ServiceConfig{Database: dbtest.SetupSlasherDB(t)})
+	require.NoError(t, err)
+
+	in := make(chan *slashertypes.IndexedAttestationWrapper)
+	out := make(chan ethpb.AttSlashing)
+
+	cancel()
+	_, err = s.Run(ctx, startup.NewClock(time.Now(), [32]byte{}), in, out)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestService_Run_NilClock(t *testing.T) {
+	s, err := New(context.Background(), &ServiceConfig{Database: dbtest.SetupSlasherDB(t)})
+	require.NoError(t, err)
+
+	_, err = s.Run(context.Background(), nil, make(chan *slashertypes.IndexedAttestationWrapper), make(chan ethpb.AttSlashing))
+	require.ErrorContains(t, "nil clock", err)
+}
+
+func TestService_Run_StartedService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Set the genesis time so that the current epoch is 4.
+	const currentEpoch = primitives.Epoch(4)
+	currentSlot := primitives.Slot(uint64(currentEpoch) * uint64(params.BeaconConfig().SlotsPerEpoch))
+	secondsSinceGenesis := time.Duration(uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	s, err := New(ctx, &ServiceConfig{Database: dbtest.SetupSlasherDB(t)})
+	require.NoError(t, err)
+	clock := startup.NewClock(time.Now().Add(-secondsSinceGenesis), [32]byte{})
+
+	in := make(chan *slashertypes.IndexedAttestationWrapper)
+	out := make(chan ethpb.AttSlashing, 16)
+	errChan := make(chan error, 1)
+
+	go func() {
+		_, err := s.Run(ctx, clock, in, out)
+		errChan <- err
+	}()
+
+	// Queued attestations are processed concurrently with the stream, as a started service does.
+	queueDone := make(chan struct{})
+	go func() {
+		defer close(queueDone)
+		for i := uint64(0); i < 64; i++ {
+			queuedAtt := createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{i}, []byte{1})
+			s.processAttestations(ctx, []*slashertypes.IndexedAttestationWrapper{queuedAtt}, currentSlot)
+		}
+	}()
+
+	for i := uint64(64); i < 128; i++ {
+		in <- createAttestationWrapperEmptySig(t, version.Phase0, 1, 2, []uint64{i}, []byte{1})
+	}
+
+	close(in)
+	require.NoError(t, <-errChan)
+	<-queueDone
+
+	// Validators are distinct across attestations, so nothing is slashable,
+	// and every validator spans were updated up to the current epoch.
+	require.Equal(t, 0, len(out))
+	for i := primitives.ValidatorIndex(0); i < 128; i++ {
+		require.Equal(t, currentEpoch, s.latestEpochUpdatedForValidator[i])
+	}
+}
+
+func Test_deferAttestations(t *testing.T) {
+	deferred := make([]deferredAttestation, 0)
+
+	// Fill the deferred queue up to one slot.
+	attWrappers := make([]*slashertypes.IndexedAttestationWrapper, 0, streamMaxDeferred-1)
+	for i := 0; i < streamMaxDeferred-1; i++ {
+		attWrappers = append(attWrappers, createAttestationWrapperEmptySig(t, version.Phase0, 1, 5, []uint64{uint64(i)}, nil))
+	}
+
+	deferred = deferAttestations(deferred, attWrappers, 3)
+	require.Equal(t, streamMaxDeferred-1, len(deferred))
+
+	// Only the first attestation fits in the queue, the other one is dropped.
+	first := createAttestationWrapperEmptySig(t, version.Phase0, 1, 6, []uint64{1}, nil)
+	second := createAttestationWrapperEmptySig(t, version.Phase0, 1, 6, []uint64{2}, nil)
+	deferred = deferAttestations(deferred, []*slashertypes.IndexedAttestationWrapper{first, second}, 4)
+	require.Equal(t, streamMaxDeferred, len(deferred))
+	require.Equal(t, first, deferred[streamMaxDeferred-1].attWrapper)
+	require.Equal(t, primitives.Epoch(4), deferred[streamMaxDeferred-1].deferredAt)
+
+	// A full queue drops all incoming attestations.
+	deferred = deferAttestations(deferred, []*slashertypes.IndexedAttestationWrapper{second}, 4)
+	require.Equal(t, streamMaxDeferred, len(deferred))
+}
+
+func Test_releaseDeferredAttestations(t *testing.T) {
+	ready := createAttestationWrapperEmptySig(t, version.Phase0, 1, 4, []uint64{1}, nil)
+	waiting := createAttestationWrapperEmptySig(t, version.Phase0, 1, 6, []uint64{2}, nil)
+	expired := createAttestationWrapperEmptySig(t, version.Phase0, 1, 7, []uint64{3}, nil)
+
+	deferred := []deferredAttestation{
+		{attWrapper: ready, deferredAt: 3},
+		{attWrapper: waiting, deferredAt: 3},
+		{attWrapper: expired, deferredAt: 4 - streamMaxDeferredEpochs - 1},
+	}
+
+	released, stillDeferred := releaseDeferredAttestations(deferred, 4)
+	require.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{ready}, released)
+	require.Equal(t, 1, len(stillDeferred))
+	require.Equal(t, waiting, stillDeferred[0].attWrapper)
+	require.Equal(t, primitives.Epoch(3), stillDeferred[0].deferredAt)
+}
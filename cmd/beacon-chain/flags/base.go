@@ -296,4 +296,22 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// SlasherMinSlashedValidatorsFlag defines the minimum number of validators an attester slashing must slash to be reported by the slasher.
+	SlasherMinSlashedValidatorsFlag = &cli.Uint64Flag{
+		Name:  "slasher-min-slashed-validators",
+		Usage: "Minimum number of validators an attester slashing detected by the slasher must slash to be reported. Attester slashings slashing fewer validators are suppressed. 0 reports every attester slashing.",
+		Value: 0,
+	}
+	// SlasherNearSlashableMarginFlag defines the epoch margin within which the slasher warns about attestations close to being slashable.
+	SlasherNearSlashableMarginFlag = &cli.Uint64Flag{
+		Name:  "slasher-near-slashable-margin",
+		Usage: "Epoch margin within which the slasher logs a warning for an attestation which is not slashable, but would be if its target epoch was off by at most this many epochs. 0 disables the warning.",
+		Value: 0,
+	}
+	// SlasherElementWidthFlag defines the width, in bits, of the elements stored in the slasher min and max span chunks.
+	SlasherElementWidthFlag = &cli.Uint64Flag{
+		Name:  "slasher-element-width",
+		Usage: "Width, in bits, of the elements stored in the slasher min and max span chunks: 16 or 32. 32 bits elements support histories longer than 65535 epochs, at the cost of doubling the size of the chunks. Changing it requires a new slasher database.",
+		Value: 16,
+	}
 )
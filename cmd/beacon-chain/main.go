@@ -140,6 +140,9 @@ var appFlags = []cli.Flag{
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 	flags.SlasherDirFlag,
+	flags.SlasherMinSlashedValidatorsFlag,
+	flags.SlasherNearSlashableMarginFlag,
+	flags.SlasherElementWidthFlag,
 	flags.JwtId,
 	storage.BlobStoragePathFlag,
 	storage.BlobRetentionEpochFlag,
@@ -129,6 +129,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.SlasherMinSlashedValidatorsFlag,
+			flags.SlasherNearSlashableMarginFlag,
+			flags.SlasherElementWidthFlag,
 			flags.LocalBlockValueBoost,
 			flags.MinBuilderBid,
 			flags.MinBuilderDiff,
@@ -109,7 +109,10 @@ func spanAction(cliCtx *cli.Context) error {
 
 	// variables
 	chunkKind := getChunkKind()
-	params := getSlasherParams()
+	params, err := getSlasherParams()
+	if err != nil {
+		return errors.Wrap(err, "invalid slasher parameters")
+	}
 	i := primitives.ValidatorIndex(f.ValidatorIndex)
 	epoch := primitives.Epoch(f.Epoch)
 
@@ -280,7 +283,7 @@ func getChunkKind() types.ChunkKind {
 	return chunkKind
 }
 
-func getSlasherParams() *slasher.Parameters {
+func getSlasherParams() (*slasher.Parameters, error) {
 	var (
 		chunkSize, validatorChunkSize uint64
 		historyLength                 primitives.Epoch
@@ -300,5 +303,5 @@ func getSlasherParams() *slasher.Parameters {
 	} else {
 		historyLength = slasherDefaultParams.HistoryLength()
 	}
-	return slasher.NewParams(chunkSize, validatorChunkSize, historyLength)
+	return slasher.NewParameters(chunkSize, validatorChunkSize, uint64(historyLength), slasher.ElementWidth16)
 }
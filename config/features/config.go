@@ -56,6 +56,7 @@ type Flags struct {
 
 	// Slasher toggles.
 	DisableBroadcastSlashings bool // DisableBroadcastSlashings disables p2p broadcasting of proposer and attester slashings.
+	EnableSlasherSelfCheck    bool // EnableSlasherSelfCheck checks every slasher span chunk update can be read back, and fails the update otherwise.
 
 	// Bug fixes related flags.
 	AttestTimely bool // AttestTimely fixes #8185. It is gated behind a flag to ensure beacon node's fix can safely roll out first. We'll invert this in v1.1.0.
@@ -214,6 +215,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		log.WithField(enableSlasherFlag.Name, enableSlasherFlag.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableSlasher = true
 	}
+	if ctx.Bool(enableSlasherSelfCheckFlag.Name) {
+		logEnabled(enableSlasherSelfCheckFlag)
+		cfg.EnableSlasherSelfCheck = true
+	}
 	if ctx.Bool(enableHistoricalSpaceRepresentation.Name) {
 		log.WithField(enableHistoricalSpaceRepresentation.Name, enableHistoricalSpaceRepresentation.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableHistoricalSpaceRepresentation = true
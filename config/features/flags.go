@@ -93,6 +93,10 @@ var (
 		Name:  "slasher",
 		Usage: "Enables a slasher in the beacon node for detecting slashable offenses.",
 	}
+	enableSlasherSelfCheckFlag = &cli.BoolFlag{
+		Name:  "slasher-self-check",
+		Usage: "Development only: checks every slasher span chunk update can be read back, and fails the update otherwise.",
+	}
 	enableSlashingProtectionPruning = &cli.BoolFlag{
 		Name:  "enable-slashing-protection-history-pruning",
 		Usage: "Enables the pruning of the validator client's slashing protection database.",
@@ -218,6 +222,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disablePeerScorer,
 	disableBroadcastSlashingFlag,
 	enableSlasherFlag,
+	enableSlasherSelfCheckFlag,
 	enableHistoricalSpaceRepresentation,
 	disableStakinContractCheck,
 	SaveFullExecutionPayloads,